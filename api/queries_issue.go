@@ -181,6 +181,37 @@ type CommentAuthor struct {
 	//	} `graphql:"... on User"`
 }
 
+// IssueTitleByNumber fetches just the title of an issue, for seeding pull
+// request metadata from a linked issue.
+func IssueTitleByNumber(client *Client, repo ghrepo.Interface, number int) (string, error) {
+	query := `
+	query IssueTitleByNumber($owner: String!, $repo: String!, $number: Int!) {
+		repository(owner: $owner, name: $repo) {
+			issue(number: $number) {
+				title
+			}
+		}
+	}`
+
+	variables := map[string]interface{}{
+		"owner":  repo.RepoOwner(),
+		"repo":   repo.RepoName(),
+		"number": number,
+	}
+
+	var resp struct {
+		Repository struct {
+			Issue struct {
+				Title string
+			}
+		}
+	}
+	if err := client.GraphQL(repo.RepoHost(), query, variables, &resp); err != nil {
+		return "", err
+	}
+	return resp.Repository.Issue.Title, nil
+}
+
 // IssueCreate creates an issue in a GitHub repository
 func IssueCreate(client *Client, repo *Repository, params map[string]interface{}) (*Issue, error) {
 	query := `
@@ -61,6 +61,9 @@ type PullRequest struct {
 	MergedBy            *Author
 	HeadRepositoryOwner Owner
 	HeadRepository      *PRRepository
+	Repository          struct {
+		NameWithOwner string `json:"nameWithOwner"`
+	}
 	IsCrossRepository   bool
 	IsDraft             bool
 	MaintainerCanModify bool
@@ -323,6 +323,8 @@ func IssueGraphQL(fields []string) string {
 			q = append(q, `headRepositoryOwner{id,login,...on User{name}}`)
 		case "headRepository":
 			q = append(q, `headRepository{id,name}`)
+		case "repository":
+			q = append(q, `repository{nameWithOwner}`)
 		case "assignees":
 			q = append(q, `assignees(first:100){nodes{id,login,name},totalCount}`)
 		case "labels":
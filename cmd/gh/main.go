@@ -40,6 +40,7 @@ const (
 	exitCancel  exitCode = 2
 	exitAuth    exitCode = 4
 	exitPending exitCode = 8
+	exitPartial exitCode = 16
 )
 
 func main() {
@@ -125,6 +126,8 @@ func mainRun() exitCode {
 			return exitError
 		} else if err == cmdutil.PendingError {
 			return exitPending
+		} else if errors.Is(err, cmdutil.PartialSuccessError) {
+			return exitPartial
 		} else if cmdutil.IsUserCancellation(err) {
 			if errors.Is(err, terminal.InterruptErr) {
 				// ensure the next shell prompt will start on its own line
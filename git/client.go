@@ -7,12 +7,14 @@ import (
 	"fmt"
 	"io"
 	"net/url"
+	"os"
 	"os/exec"
 	"path"
 	"regexp"
 	"runtime"
 	"slices"
 	"sort"
+	"strconv"
 	"strings"
 	"sync"
 
@@ -139,6 +141,21 @@ func (c *Client) Remotes(ctx context.Context) (RemoteSet, error) {
 	return remotes, nil
 }
 
+// RemoteExists reports whether a remote with the given name is configured.
+// A missing remote is not an error, enabling idempotent remote setup.
+func (c *Client) RemoteExists(ctx context.Context, name string) (bool, error) {
+	remotes, err := c.Remotes(ctx)
+	if err != nil {
+		return false, err
+	}
+	for _, remote := range remotes {
+		if remote.Name == name {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
 func (c *Client) UpdateRemoteURL(ctx context.Context, name, url string) error {
 	args := []string{"remote", "set-url", name, url}
 	cmd, err := c.Command(ctx, args...)
@@ -152,6 +169,30 @@ func (c *Client) UpdateRemoteURL(ctx context.Context, name, url string) error {
 	return nil
 }
 
+// SetRemoteURL changes the URL of a remote, updating the push URL instead of
+// the fetch URL when push is true. It returns a RemoteNotFoundError when the
+// remote does not exist.
+func (c *Client) SetRemoteURL(ctx context.Context, name, url string, push bool) error {
+	args := []string{"remote", "set-url"}
+	if push {
+		args = append(args, "--push")
+	}
+	args = append(args, name, url)
+	cmd, err := c.Command(ctx, args...)
+	if err != nil {
+		return err
+	}
+	_, err = cmd.Output()
+	if err != nil {
+		var gitErr *GitError
+		if errors.As(err, &gitErr) && strings.Contains(gitErr.Stderr, "No such remote") {
+			return &RemoteNotFoundError{Name: name, err: err}
+		}
+		return err
+	}
+	return nil
+}
+
 func (c *Client) SetRemoteResolution(ctx context.Context, name, resolution string) error {
 	args := []string{"config", "--add", fmt.Sprintf("remote.%s.gh-resolved", name), resolution}
 	cmd, err := c.Command(ctx, args...)
@@ -186,6 +227,29 @@ func (c *Client) CurrentBranch(ctx context.Context) (string, error) {
 	return strings.TrimPrefix(branch, "refs/heads/"), nil
 }
 
+// DefaultBranch determines the default branch of a remote by reading the
+// refs/remotes/<remote>/HEAD symbolic ref, which git records on clone. This
+// works offline, unlike querying the remote or the API.
+func (c *Client) DefaultBranch(ctx context.Context, remote string) (string, error) {
+	symref := fmt.Sprintf("refs/remotes/%s/HEAD", remote)
+	args := []string{"symbolic-ref", "--quiet", symref}
+	cmd, err := c.Command(ctx, args...)
+	if err != nil {
+		return "", err
+	}
+	out, err := cmd.Output()
+	if err != nil {
+		var gitErr *GitError
+		if ok := errors.As(err, &gitErr); ok && len(gitErr.Stderr) == 0 {
+			gitErr.Stderr = fmt.Sprintf("could not determine default branch for remote %q", remote)
+			return "", gitErr
+		}
+		return "", err
+	}
+	branch := firstLine(out)
+	return strings.TrimPrefix(branch, fmt.Sprintf("refs/remotes/%s/", remote)), nil
+}
+
 // ShowRefs resolves fully-qualified refs to commit hashes.
 func (c *Client) ShowRefs(ctx context.Context, refs []string) ([]Ref, error) {
 	args := append([]string{"show-ref", "--verify", "--"}, refs...)
@@ -228,6 +292,42 @@ func (c *Client) Config(ctx context.Context, name string) (string, error) {
 	return firstLine(out), nil
 }
 
+// ApplyInsteadOf applies any url.<base>.insteadOf rewrites from git config
+// to a user-supplied URL and returns the effective URL git would use. The
+// raw `git remote -v` output already has rewrites applied, but URLs taken
+// from user input do not; apply this before parsing those so resolution
+// matches git's behavior. URLs with no matching rewrite come back unchanged.
+func (c *Client) ApplyInsteadOf(ctx context.Context, urlStr string) (string, error) {
+	args := []string{"ls-remote", "--get-url", urlStr}
+	cmd, err := c.Command(ctx, args...)
+	if err != nil {
+		return "", err
+	}
+	out, err := cmd.Output()
+	if err != nil {
+		return "", err
+	}
+	return firstLine(out), nil
+}
+
+// PullRequestNumberForBranch returns the pull request number that gh pr checkout
+// recorded for branch, or zero when none is recorded.
+func (c *Client) PullRequestNumberForBranch(ctx context.Context, branch string) (int, error) {
+	out, err := c.Config(ctx, fmt.Sprintf("branch.%s.gh-pr-number", branch))
+	if err != nil {
+		var gitErr *GitError
+		if ok := errors.As(err, &gitErr); ok && gitErr.ExitCode == 1 {
+			return 0, nil
+		}
+		return 0, err
+	}
+	number, err := strconv.Atoi(out)
+	if err != nil {
+		return 0, fmt.Errorf("invalid gh-pr-number recorded for branch %q: %q", branch, out)
+	}
+	return number, nil
+}
+
 func (c *Client) UncommittedChangeCount(ctx context.Context) (int, error) {
 	args := []string{"status", "--porcelain"}
 	cmd, err := c.Command(ctx, args...)
@@ -385,6 +485,35 @@ func (c *Client) DeleteLocalBranch(ctx context.Context, branch string) error {
 	return nil
 }
 
+// DeleteBranch removes a local branch. Without force, git refuses to delete
+// a branch that is checked out or not fully merged; those failures are
+// reported as ErrBranchCheckedOut and ErrBranchNotFullyMerged respectively.
+func (c *Client) DeleteBranch(ctx context.Context, branch string, force bool) error {
+	deleteFlag := "-d"
+	if force {
+		deleteFlag = "-D"
+	}
+	args := []string{"branch", deleteFlag, branch}
+	cmd, err := c.Command(ctx, args...)
+	if err != nil {
+		return err
+	}
+	_, err = cmd.Output()
+	if err != nil {
+		var gitErr *GitError
+		if errors.As(err, &gitErr) {
+			if strings.Contains(gitErr.Stderr, "checked out at") || strings.Contains(gitErr.Stderr, "used by worktree") {
+				return fmt.Errorf("%w: %s", ErrBranchCheckedOut, branch)
+			}
+			if strings.Contains(gitErr.Stderr, "not fully merged") {
+				return fmt.Errorf("%w: %s", ErrBranchNotFullyMerged, branch)
+			}
+		}
+		return err
+	}
+	return nil
+}
+
 func (c *Client) CheckoutBranch(ctx context.Context, branch string) error {
 	args := []string{"checkout", branch}
 	cmd, err := c.Command(ctx, args...)
@@ -559,6 +688,25 @@ func (c *Client) Fetch(ctx context.Context, remote string, refspec string, mods
 	return cmd.Run()
 }
 
+// FetchRefspecs is like Fetch but accepts multiple refspecs and can prune
+// remote-tracking references that no longer exist on the remote.
+func (c *Client) FetchRefspecs(ctx context.Context, remote string, refspecs []string, prune bool, mods ...CommandModifier) error {
+	args := []string{"fetch"}
+	if prune {
+		args = append(args, "--prune")
+	}
+	args = append(args, remote)
+	args = append(args, refspecs...)
+	cmd, err := c.AuthenticatedCommand(ctx, args...)
+	if err != nil {
+		return err
+	}
+	for _, mod := range mods {
+		mod(cmd)
+	}
+	return cmd.Run()
+}
+
 func (c *Client) Pull(ctx context.Context, remote, branch string, mods ...CommandModifier) error {
 	args := []string{"pull", "--ff-only"}
 	if remote != "" && branch != "" {
@@ -616,6 +764,9 @@ func (c *Client) Clone(ctx context.Context, cloneURL string, args []string, mods
 }
 
 func resolveGitPath() (string, error) {
+	if path := os.Getenv("GH_GIT_PATH"); path != "" {
+		return path, nil
+	}
 	path, err := safeexec.LookPath("git")
 	if err != nil {
 		if errors.Is(err, exec.ErrNotFound) {
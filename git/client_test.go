@@ -60,6 +60,15 @@ func TestClientCommand(t *testing.T) {
 	}
 }
 
+func TestClientCommandGitPathOverride(t *testing.T) {
+	t.Setenv("GH_GIT_PATH", "path/to/other/git")
+	client := Client{}
+	cmd, err := client.Command(context.Background(), "ref-log")
+	assert.NoError(t, err)
+	assert.Equal(t, "path/to/other/git", cmd.Path)
+	assert.Equal(t, []string{"path/to/other/git", "ref-log"}, cmd.Args)
+}
+
 func TestClientAuthenticatedCommand(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -128,6 +137,34 @@ func TestClientRemotes(t *testing.T) {
 	assert.Equal(t, "other", rs[3].Resolved)
 }
 
+func TestClientRemoteExists(t *testing.T) {
+	tempDir := t.TempDir()
+	initRepo(t, tempDir)
+	gitDir := filepath.Join(tempDir, ".git")
+	remoteFile := filepath.Join(gitDir, "config")
+	remotes := `
+[remote "origin"]
+	url = git@example.com:monalisa/origin.git
+`
+	f, err := os.OpenFile(remoteFile, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0755)
+	assert.NoError(t, err)
+	_, err = f.Write([]byte(remotes))
+	assert.NoError(t, err)
+	err = f.Close()
+	assert.NoError(t, err)
+	client := Client{
+		RepoDir: tempDir,
+	}
+
+	exists, err := client.RemoteExists(context.Background(), "origin")
+	assert.NoError(t, err)
+	assert.True(t, exists)
+
+	exists, err = client.RemoteExists(context.Background(), "upstream")
+	assert.NoError(t, err)
+	assert.False(t, exists)
+}
+
 func TestClientRemotes_no_resolved_remote(t *testing.T) {
 	tempDir := t.TempDir()
 	initRepo(t, tempDir)
@@ -237,6 +274,62 @@ func TestClientUpdateRemoteURL(t *testing.T) {
 	}
 }
 
+func TestClientSetRemoteURL(t *testing.T) {
+	tests := []struct {
+		name          string
+		push          bool
+		cmdExitStatus int
+		cmdStdout     string
+		cmdStderr     string
+		wantCmdArgs   string
+		wantErrorMsg  string
+		wantNotFound  bool
+	}{
+		{
+			name:        "set fetch url",
+			wantCmdArgs: `path/to/git remote set-url test https://test.com`,
+		},
+		{
+			name:        "set push url",
+			push:        true,
+			wantCmdArgs: `path/to/git remote set-url --push test https://test.com`,
+		},
+		{
+			name:          "unknown remote",
+			cmdExitStatus: 2,
+			cmdStderr:     "error: No such remote 'test'",
+			wantCmdArgs:   `path/to/git remote set-url test https://test.com`,
+			wantErrorMsg:  `git: no remote named "test"`,
+			wantNotFound:  true,
+		},
+		{
+			name:          "git error",
+			cmdExitStatus: 1,
+			cmdStderr:     "git error message",
+			wantCmdArgs:   `path/to/git remote set-url test https://test.com`,
+			wantErrorMsg:  "failed to run git: git error message",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd, cmdCtx := createCommandContext(t, tt.cmdExitStatus, tt.cmdStdout, tt.cmdStderr)
+			client := Client{
+				GitPath:        "path/to/git",
+				commandContext: cmdCtx,
+			}
+			err := client.SetRemoteURL(context.Background(), "test", "https://test.com", tt.push)
+			assert.Equal(t, tt.wantCmdArgs, strings.Join(cmd.Args[3:], " "))
+			if tt.wantErrorMsg == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.wantErrorMsg)
+			}
+			var notFoundErr *RemoteNotFoundError
+			assert.Equal(t, tt.wantNotFound, errors.As(err, &notFoundErr))
+		})
+	}
+}
+
 func TestClientSetRemoteResolution(t *testing.T) {
 	tests := []struct {
 		name          string
@@ -330,6 +423,34 @@ func TestClientCurrentBranch(t *testing.T) {
 	}
 }
 
+func TestClientCurrentBranchDetachedHead(t *testing.T) {
+	dir := t.TempDir()
+	initRepo(t, dir)
+	client := Client{RepoDir: dir}
+
+	for _, args := range [][]string{
+		{"checkout", "--quiet", "-b", "trunk"},
+		{"-c", "user.name=test", "-c", "user.email=test@example.com", "commit", "--quiet", "--allow-empty", "-m", "initial"},
+	} {
+		cmd, err := client.Command(context.Background(), args...)
+		assert.NoError(t, err)
+		_, err = cmd.Output()
+		assert.NoError(t, err)
+	}
+
+	branch, err := client.CurrentBranch(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, "trunk", branch)
+
+	cmd, err := client.Command(context.Background(), "checkout", "--quiet", "--detach")
+	assert.NoError(t, err)
+	_, err = cmd.Output()
+	assert.NoError(t, err)
+
+	_, err = client.CurrentBranch(context.Background())
+	assert.ErrorIs(t, err, ErrNotOnAnyBranch)
+}
+
 func TestClientShowRefs(t *testing.T) {
 	tests := []struct {
 		name          string
@@ -418,6 +539,110 @@ func TestClientConfig(t *testing.T) {
 	}
 }
 
+func TestClientApplyInsteadOf(t *testing.T) {
+	tests := []struct {
+		name          string
+		cmdExitStatus int
+		cmdStdout     string
+		cmdStderr     string
+		wantCmdArgs   string
+		wantOut       string
+		wantErrorMsg  string
+	}{
+		{
+			name:        "insteadOf rewrites custom prefix to https",
+			cmdStdout:   "https://github.com/owner/repo.git",
+			wantCmdArgs: `path/to/git ls-remote --get-url gh:owner/repo`,
+			wantOut:     "https://github.com/owner/repo.git",
+		},
+		{
+			name:        "no matching rewrite returns url unchanged",
+			cmdStdout:   "gh:owner/repo",
+			wantCmdArgs: `path/to/git ls-remote --get-url gh:owner/repo`,
+			wantOut:     "gh:owner/repo",
+		},
+		{
+			name:          "git error",
+			cmdExitStatus: 1,
+			cmdStderr:     "git error message",
+			wantCmdArgs:   `path/to/git ls-remote --get-url gh:owner/repo`,
+			wantErrorMsg:  "failed to run git: git error message",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd, cmdCtx := createCommandContext(t, tt.cmdExitStatus, tt.cmdStdout, tt.cmdStderr)
+			client := Client{
+				GitPath:        "path/to/git",
+				commandContext: cmdCtx,
+			}
+			out, err := client.ApplyInsteadOf(context.Background(), "gh:owner/repo")
+			assert.Equal(t, tt.wantCmdArgs, strings.Join(cmd.Args[3:], " "))
+			if tt.wantErrorMsg == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.wantErrorMsg)
+			}
+			assert.Equal(t, tt.wantOut, out)
+		})
+	}
+}
+
+func TestClientPullRequestNumberForBranch(t *testing.T) {
+	tests := []struct {
+		name          string
+		cmdExitStatus int
+		cmdStdout     string
+		cmdStderr     string
+		wantCmdArgs   string
+		wantNumber    int
+		wantErrorMsg  string
+	}{
+		{
+			name:        "recorded number",
+			cmdStdout:   "123\n",
+			wantCmdArgs: `path/to/git config branch.feature.gh-pr-number`,
+			wantNumber:  123,
+		},
+		{
+			name:          "no recorded number",
+			cmdExitStatus: 1,
+			wantCmdArgs:   `path/to/git config branch.feature.gh-pr-number`,
+			wantNumber:    0,
+		},
+		{
+			name:         "invalid recorded value",
+			cmdStdout:    "abc\n",
+			wantCmdArgs:  `path/to/git config branch.feature.gh-pr-number`,
+			wantErrorMsg: `invalid gh-pr-number recorded for branch "feature": "abc"`,
+		},
+		{
+			name:          "git error",
+			cmdExitStatus: 2,
+			cmdStderr:     "git error message",
+			wantCmdArgs:   `path/to/git config branch.feature.gh-pr-number`,
+			wantErrorMsg:  "failed to run git: git error message",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd, cmdCtx := createCommandContext(t, tt.cmdExitStatus, tt.cmdStdout, tt.cmdStderr)
+			client := Client{
+				GitPath:        "path/to/git",
+				commandContext: cmdCtx,
+			}
+			number, err := client.PullRequestNumberForBranch(context.Background(), "feature")
+			assert.Equal(t, tt.wantCmdArgs, strings.Join(cmd.Args[3:], " "))
+			if tt.wantErrorMsg == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.wantErrorMsg)
+			}
+			assert.Equal(t, tt.wantNumber, number)
+		})
+	}
+}
+
 func TestClientUncommittedChangeCount(t *testing.T) {
 	tests := []struct {
 		name            string
@@ -775,6 +1000,69 @@ func TestClientDeleteLocalTag(t *testing.T) {
 	}
 }
 
+func TestClientDeleteBranch(t *testing.T) {
+	tests := []struct {
+		name          string
+		force         bool
+		cmdExitStatus int
+		cmdStdout     string
+		cmdStderr     string
+		wantCmdArgs   string
+		wantError     error
+		wantErrorMsg  string
+	}{
+		{
+			name:        "delete merged branch",
+			wantCmdArgs: `path/to/git branch -d trunk`,
+		},
+		{
+			name:          "unmerged branch without force",
+			cmdExitStatus: 1,
+			cmdStderr:     "error: the branch 'trunk' is not fully merged",
+			wantCmdArgs:   `path/to/git branch -d trunk`,
+			wantError:     ErrBranchNotFullyMerged,
+		},
+		{
+			name:          "checked out branch",
+			cmdExitStatus: 1,
+			cmdStderr:     "error: Cannot delete branch 'trunk' checked out at '/path/to/repo'",
+			wantCmdArgs:   `path/to/git branch -d trunk`,
+			wantError:     ErrBranchCheckedOut,
+		},
+		{
+			name:        "force delete",
+			force:       true,
+			wantCmdArgs: `path/to/git branch -D trunk`,
+		},
+		{
+			name:          "git error",
+			cmdExitStatus: 1,
+			cmdStderr:     "git error message",
+			wantCmdArgs:   `path/to/git branch -d trunk`,
+			wantErrorMsg:  "failed to run git: git error message",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd, cmdCtx := createCommandContext(t, tt.cmdExitStatus, tt.cmdStdout, tt.cmdStderr)
+			client := Client{
+				GitPath:        "path/to/git",
+				commandContext: cmdCtx,
+			}
+			err := client.DeleteBranch(context.Background(), "trunk", tt.force)
+			assert.Equal(t, tt.wantCmdArgs, strings.Join(cmd.Args[3:], " "))
+			switch {
+			case tt.wantError != nil:
+				assert.ErrorIs(t, err, tt.wantError)
+			case tt.wantErrorMsg != "":
+				assert.EqualError(t, err, tt.wantErrorMsg)
+			default:
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestClientDeleteLocalBranch(t *testing.T) {
 	tests := []struct {
 		name          string
@@ -1174,6 +1462,59 @@ func TestClientFetch(t *testing.T) {
 	}
 }
 
+func TestClientFetchRefspecs(t *testing.T) {
+	tests := []struct {
+		name          string
+		refspecs      []string
+		prune         bool
+		cmdExitStatus int
+		cmdStdout     string
+		cmdStderr     string
+		wantCmdArgs   string
+		wantErrorMsg  string
+	}{
+		{
+			name:        "multiple refspecs",
+			refspecs:    []string{"trunk", "feature"},
+			wantCmdArgs: `path/to/git -c credential.helper= -c credential.helper=!"gh" auth git-credential fetch origin trunk feature`,
+		},
+		{
+			name:        "prune",
+			refspecs:    []string{"trunk"},
+			prune:       true,
+			wantCmdArgs: `path/to/git -c credential.helper= -c credential.helper=!"gh" auth git-credential fetch --prune origin trunk`,
+		},
+		{
+			name:        "no refspecs",
+			wantCmdArgs: `path/to/git -c credential.helper= -c credential.helper=!"gh" auth git-credential fetch origin`,
+		},
+		{
+			name:          "git error",
+			refspecs:      []string{"trunk"},
+			cmdExitStatus: 1,
+			cmdStderr:     "git error message",
+			wantCmdArgs:   `path/to/git -c credential.helper= -c credential.helper=!"gh" auth git-credential fetch origin trunk`,
+			wantErrorMsg:  "failed to run git: git error message",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd, cmdCtx := createCommandContext(t, tt.cmdExitStatus, tt.cmdStdout, tt.cmdStderr)
+			client := Client{
+				GitPath:        "path/to/git",
+				commandContext: cmdCtx,
+			}
+			err := client.FetchRefspecs(context.Background(), "origin", tt.refspecs, tt.prune)
+			assert.Equal(t, tt.wantCmdArgs, strings.Join(cmd.Args[3:], " "))
+			if tt.wantErrorMsg == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.wantErrorMsg)
+			}
+		})
+	}
+}
+
 func TestClientPull(t *testing.T) {
 	tests := []struct {
 		name          string
@@ -1426,6 +1767,24 @@ func TestClientAddRemote(t *testing.T) {
 	}
 }
 
+func TestClientDefaultBranch(t *testing.T) {
+	dir := t.TempDir()
+	initRepo(t, dir)
+	client := Client{RepoDir: dir}
+
+	_, err := client.DefaultBranch(context.Background(), "origin")
+	assert.ErrorContains(t, err, `could not determine default branch for remote "origin"`)
+
+	cmd, err := client.Command(context.Background(), "symbolic-ref", "refs/remotes/origin/HEAD", "refs/remotes/origin/trunk")
+	assert.NoError(t, err)
+	_, err = cmd.Output()
+	assert.NoError(t, err)
+
+	branch, err := client.DefaultBranch(context.Background(), "origin")
+	assert.NoError(t, err)
+	assert.Equal(t, "trunk", branch)
+}
+
 func initRepo(t *testing.T, dir string) {
 	errBuf := &bytes.Buffer{}
 	inBuf := &bytes.Buffer{}
@@ -8,6 +8,14 @@ import (
 // ErrNotOnAnyBranch indicates that the user is in detached HEAD state.
 var ErrNotOnAnyBranch = errors.New("git: not on any branch")
 
+// ErrBranchCheckedOut indicates that a branch could not be deleted because
+// it is currently checked out.
+var ErrBranchCheckedOut = errors.New("git: branch is checked out")
+
+// ErrBranchNotFullyMerged indicates that a branch could not be deleted
+// without force because it contains unmerged commits.
+var ErrBranchNotFullyMerged = errors.New("git: branch is not fully merged")
+
 type NotInstalled struct {
 	message string
 	err     error
@@ -21,6 +29,21 @@ func (e *NotInstalled) Unwrap() error {
 	return e.err
 }
 
+// RemoteNotFoundError indicates that an operation referenced a git remote
+// that does not exist.
+type RemoteNotFoundError struct {
+	Name string
+	err  error
+}
+
+func (e *RemoteNotFoundError) Error() string {
+	return fmt.Sprintf("git: no remote named %q", e.Name)
+}
+
+func (e *RemoteNotFoundError) Unwrap() error {
+	return e.err
+}
+
 type GitError struct {
 	ExitCode int
 	Stderr   string
@@ -1,6 +1,7 @@
 package git
 
 import (
+	"fmt"
 	"net/url"
 	"strings"
 )
@@ -39,6 +40,37 @@ func (r *Remote) String() string {
 	return r.Name
 }
 
+// GoString is a debugging representation that, unlike String, includes the
+// fetch URL, push URL, and resolved value.
+func (r *Remote) GoString() string {
+	fetchURL := "<nil>"
+	if r.FetchURL != nil {
+		fetchURL = r.FetchURL.String()
+	}
+	pushURL := "<nil>"
+	if r.PushURL != nil {
+		pushURL = r.PushURL.String()
+	}
+	return fmt.Sprintf("&Remote{Name: %q, Resolved: %q, FetchURL: %s, PushURL: %s}", r.Name, r.Resolved, fetchURL, pushURL)
+}
+
+// ResolvedProject parses the remote's gh-resolved config value into
+// structured fields. The "base" sentinel sets isBase; an explicit value such
+// as "owner/repo", optionally prefixed with a host, yields owner and name.
+func (r *Remote) ResolvedProject() (owner, name string, isBase bool, err error) {
+	switch r.Resolved {
+	case "":
+		return "", "", false, fmt.Errorf("remote %q has no resolution", r.Name)
+	case "base":
+		return "", "", true, nil
+	}
+	parts := strings.Split(r.Resolved, "/")
+	if len(parts) < 2 || parts[len(parts)-2] == "" || parts[len(parts)-1] == "" {
+		return "", "", false, fmt.Errorf("invalid resolution %q for remote %q", r.Resolved, r.Name)
+	}
+	return parts[len(parts)-2], parts[len(parts)-1], false, nil
+}
+
 func NewRemote(name string, u string) *Remote {
 	pu, _ := url.Parse(u)
 	return &Remote{
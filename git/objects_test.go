@@ -0,0 +1,82 @@
+package git
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRemoteString(t *testing.T) {
+	remote := NewRemote("origin", "https://github.com/OWNER/REPO.git")
+	assert.Equal(t, "origin", remote.String())
+}
+
+func TestRemoteGoString(t *testing.T) {
+	remote := NewRemote("origin", "https://github.com/OWNER/REPO.git")
+	remote.PushURL, _ = remote.PushURL.Parse("https://github.com/FORKOWNER/REPO.git")
+	remote.Resolved = "base"
+
+	got := remote.GoString()
+	assert.Contains(t, got, `Name: "origin"`)
+	assert.Contains(t, got, "FetchURL: https://github.com/OWNER/REPO.git")
+	assert.Contains(t, got, "PushURL: https://github.com/FORKOWNER/REPO.git")
+	assert.Contains(t, got, `Resolved: "base"`)
+}
+
+func TestRemoteResolvedProject(t *testing.T) {
+	tests := []struct {
+		name      string
+		resolved  string
+		wantOwner string
+		wantName  string
+		wantBase  bool
+		wantErr   string
+	}{
+		{
+			name:     "base sentinel",
+			resolved: "base",
+			wantBase: true,
+		},
+		{
+			name:      "owner/repo",
+			resolved:  "monalisa/hello",
+			wantOwner: "monalisa",
+			wantName:  "hello",
+		},
+		{
+			name:      "host/owner/repo",
+			resolved:  "example.com/monalisa/hello",
+			wantOwner: "monalisa",
+			wantName:  "hello",
+		},
+		{
+			name:     "unresolved",
+			resolved: "",
+			wantErr:  `remote "origin" has no resolution`,
+		},
+		{
+			name:     "malformed",
+			resolved: "hello",
+			wantErr:  `invalid resolution "hello" for remote "origin"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			remote := &Remote{Name: "origin", Resolved: tt.resolved}
+			owner, name, isBase, err := remote.ResolvedProject()
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantOwner, owner)
+			assert.Equal(t, tt.wantName, name)
+			assert.Equal(t, tt.wantBase, isBase)
+		})
+	}
+}
+
+func TestRemoteGoStringNilURLs(t *testing.T) {
+	remote := &Remote{Name: "origin"}
+	assert.Equal(t, `&Remote{Name: "origin", Resolved: "", FetchURL: <nil>, PushURL: <nil>}`, remote.GoString())
+}
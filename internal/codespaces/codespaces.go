@@ -4,8 +4,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"net"
 	"net/http"
+	"sync"
 	"time"
 
 	"github.com/cenkalti/backoff/v4"
@@ -46,10 +48,41 @@ func (e *TimeoutError) Error() string {
 	return e.message
 }
 
+// ConnectOptions configures how long we wait for a codespace to become connectable.
+type ConnectOptions struct {
+	// MaxAttempts caps how many times the codespace is refreshed while waiting
+	// for it to become ready, regardless of elapsed time. Zero means no cap.
+	MaxAttempts int
+	// DialTimeout bounds each tunnel connection attempt once the codespace
+	// is ready. Zero means no bound.
+	DialTimeout time.Duration
+	// ReadinessCache, when set, is consulted before the start/backoff path
+	// and updated on success, so repeated connections to the same codespace
+	// within one invocation skip redundant state polling. Nil disables caching.
+	ReadinessCache *ReadinessCache
+}
+
+// WaitUntilReady blocks until the codespace is able to be connected to,
+// starting it if necessary. A non-zero timeout bounds the overall wait so
+// callers outside the connection path, e.g. commands that only need the
+// environment's auth to be warm, can give up early.
+func WaitUntilReady(ctx context.Context, progress progressIndicator, apiClient apiClient, codespace *api.Codespace, timeout time.Duration, opts ...ConnectOptions) (*api.Codespace, error) {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+	codespace, _, err := waitUntilCodespaceConnectionReady(ctx, progress, apiClient, codespace, opts...)
+	if errors.Is(err, context.DeadlineExceeded) {
+		return nil, errors.New("timed out while waiting for the codespace to start")
+	}
+	return codespace, err
+}
+
 // GetCodespaceConnection waits until a codespace is able
 // to be connected to and initializes a connection to it.
-func GetCodespaceConnection(ctx context.Context, progress progressIndicator, apiClient apiClient, codespace *api.Codespace) (*connection.CodespaceConnection, error) {
-	codespace, err := waitUntilCodespaceConnectionReady(ctx, progress, apiClient, codespace)
+func GetCodespaceConnection(ctx context.Context, progress progressIndicator, apiClient apiClient, codespace *api.Codespace, opts ...ConnectOptions) (*connection.CodespaceConnection, error) {
+	codespace, startedCold, err := waitUntilCodespaceConnectionReady(ctx, progress, apiClient, codespace, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -62,16 +95,105 @@ func GetCodespaceConnection(ctx context.Context, progress progressIndicator, api
 		return nil, fmt.Errorf("error getting http client: %w", err)
 	}
 
-	return connection.NewCodespaceConnection(ctx, codespace, httpClient)
+	conn, err := connection.NewCodespaceConnection(ctx, codespace, httpClient)
+	if err != nil {
+		return nil, err
+	}
+	conn.StartedCold = startedCold
+	if len(opts) > 0 {
+		conn.DialTimeout = opts[0].DialTimeout
+	}
+	return conn, nil
+}
+
+// readinessCacheTTL bounds how long a "connection established" result is
+// reused before the codespace state is re-checked. It is short on purpose:
+// the cache only exists so connections to the same codespace in quick
+// succession don't re-trigger start checks and backoff polling.
+const readinessCacheTTL = 30 * time.Second
+
+// ReadinessCache remembers which codespaces were recently confirmed
+// connection-ready. Callers own a cache and pass it through ConnectOptions;
+// there is deliberately no package-level cache so readiness results never
+// leak between unrelated sessions.
+type ReadinessCache struct {
+	mu      sync.Mutex
+	ttl     time.Duration
+	now     func() time.Time
+	entries map[string]readyEntry
+}
+
+type readyEntry struct {
+	codespace *api.Codespace
+	expiresAt time.Time
+}
+
+// NewReadinessCache returns a cache with the default TTL.
+func NewReadinessCache() *ReadinessCache {
+	return newReadinessCache(readinessCacheTTL)
+}
+
+func newReadinessCache(ttl time.Duration) *ReadinessCache {
+	return &ReadinessCache{ttl: ttl, now: time.Now, entries: map[string]readyEntry{}}
+}
+
+func (c *ReadinessCache) get(name string) *api.Codespace {
+	if c == nil {
+		return nil
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.entries[name]
+	if !ok {
+		return nil
+	}
+	if c.now().After(e.expiresAt) {
+		delete(c.entries, name)
+		return nil
+	}
+	return e.codespace
+}
+
+func (c *ReadinessCache) put(codespace *api.Codespace) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries[codespace.Name] = readyEntry{codespace: codespace, expiresAt: c.now().Add(c.ttl)}
+}
+
+// Invalidate drops the cached readiness result for the named codespace so
+// the next connection re-checks its state, e.g. after stopping or rebuilding
+// it. Invalidating a nil cache is a no-op.
+func (c *ReadinessCache) Invalidate(name string) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.entries, name)
 }
 
 // waitUntilCodespaceConnectionReady waits for a Codespace to be running and is able to be connected to.
-func waitUntilCodespaceConnectionReady(ctx context.Context, progress progressIndicator, apiClient apiClient, codespace *api.Codespace) (*api.Codespace, error) {
+// The returned boolean reports whether the codespace had to be started first.
+func waitUntilCodespaceConnectionReady(ctx context.Context, progress progressIndicator, apiClient apiClient, codespace *api.Codespace, opts ...ConnectOptions) (*api.Codespace, bool, error) {
+	var connectOpts ConnectOptions
+	if len(opts) > 0 {
+		connectOpts = opts[0]
+	}
+
+	if cached := connectOpts.ReadinessCache.get(codespace.Name); cached != nil {
+		return cached, false, nil
+	}
+
+	startedCold := false
 	if codespace.State != api.CodespaceStateAvailable {
+		startedCold = true
 		progress.StartProgressIndicatorWithLabel("Starting codespace")
 		defer progress.StopProgressIndicator()
 		if err := apiClient.StartCodespace(ctx, codespace.Name); err != nil {
-			return nil, fmt.Errorf("error starting codespace: %w", err)
+			return nil, startedCold, fmt.Errorf("error starting codespace: %w", err)
 		}
 	}
 
@@ -81,27 +203,88 @@ func waitUntilCodespaceConnectionReady(ctx context.Context, progress progressInd
 		expBackoff.MaxInterval = 10 * time.Second
 		expBackoff.MaxElapsedTime = 5 * time.Minute
 
+		lastState := codespace.State
+		attempts := 0
 		err := backoff.Retry(func() error {
 			var err error
 			codespace, err = apiClient.GetCodespace(ctx, codespace.Name, true)
 			if err != nil {
 				return backoff.Permanent(fmt.Errorf("error getting codespace: %w", err))
 			}
+			attempts++
+
+			// Surface state transitions so the user can see progress while waiting,
+			// skipping refreshes where the state hasn't changed.
+			if codespace.State != lastState {
+				progress.StartProgressIndicatorWithLabel(fmt.Sprintf("Codespace state: %s → %s", lastState, codespace.State))
+				lastState = codespace.State
+			}
 
 			if connectionReady(codespace) {
 				return nil
 			}
 
+			if connectOpts.MaxAttempts > 0 && attempts >= connectOpts.MaxAttempts {
+				return backoff.Permanent(fmt.Errorf("gave up after %d attempts", attempts))
+			}
+
 			return &TimeoutError{message: "codespace not ready yet"}
 		}, backoff.WithContext(expBackoff, ctx))
 		if err != nil {
 			var timeoutErr *TimeoutError
 			if errors.As(err, &timeoutErr) {
-				return nil, errors.New("timed out while waiting for the codespace to start")
+				return nil, startedCold, errors.New("timed out while waiting for the codespace to start")
 			}
 
-			return nil, err
+			return nil, startedCold, err
+		}
+	}
+
+	connectOpts.ReadinessCache.put(codespace)
+
+	return codespace, startedCold, nil
+}
+
+// WaitForRebuild polls the codespace until a rebuild completes and the
+// codespace is available again. Polling uses capped exponential backoff with
+// jitter so parallel clients don't poll in lockstep, and gives up when
+// timeout elapses. State transitions are surfaced through progress.
+// Cancelling ctx aborts the wait promptly and returns the context error.
+// Callers holding a ReadinessCache should invalidate the codespace's entry
+// once the rebuild starts.
+func WaitForRebuild(ctx context.Context, progress progressIndicator, apiClient apiClient, name string, timeout time.Duration) (*api.Codespace, error) {
+	expBackoff := backoff.NewExponentialBackOff()
+	expBackoff.Multiplier = 1.5
+	expBackoff.RandomizationFactor = 0.3
+	expBackoff.MaxInterval = 10 * time.Second
+	expBackoff.MaxElapsedTime = timeout
+
+	var codespace *api.Codespace
+	lastState := ""
+	err := backoff.Retry(func() error {
+		cs, err := apiClient.GetCodespace(ctx, name, false)
+		if err != nil {
+			return backoff.Permanent(fmt.Errorf("error getting codespace: %w", err))
+		}
+
+		if cs.State != lastState {
+			progress.StartProgressIndicatorWithLabel(fmt.Sprintf("Codespace state: %s", cs.State))
+			lastState = cs.State
+		}
+
+		if cs.State == api.CodespaceStateAvailable {
+			codespace = cs
+			return nil
 		}
+
+		return &TimeoutError{message: "rebuild not finished yet"}
+	}, backoff.WithContext(expBackoff, ctx))
+	if err != nil {
+		var timeoutErr *TimeoutError
+		if errors.As(err, &timeoutErr) {
+			return nil, errors.New("timed out while waiting for the codespace to rebuild")
+		}
+		return nil, err
 	}
 
 	return codespace, nil
@@ -126,3 +309,84 @@ func ListenTCP(port int, allInterfaces bool) (*net.TCPListener, int, error) {
 
 	return listener, port, nil
 }
+
+// PortDialer opens a connection to a port on the remote environment, e.g.
+// through a codespace tunnel session.
+type PortDialer interface {
+	Dial(ctx context.Context, port int) (io.ReadWriteCloser, error)
+}
+
+// ForwardPort accepts connections on the listener and bidirectionally copies
+// each one to the remote port via the dialer until ctx is cancelled.
+// Connection opens and closes are logged to logOut when it is non-nil.
+func ForwardPort(ctx context.Context, dialer PortDialer, listener *net.TCPListener, remotePort int, logOut io.Writer) error {
+	if logOut == nil {
+		logOut = io.Discard
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// unblock Accept when the context is cancelled
+	go func() {
+		<-ctx.Done()
+		_ = listener.Close()
+	}()
+
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			return fmt.Errorf("failed to accept connection: %w", err)
+		}
+
+		go func() {
+			defer conn.Close()
+			fmt.Fprintf(logOut, "connection to port %d opened\n", remotePort)
+			defer fmt.Fprintf(logOut, "connection to port %d closed\n", remotePort)
+
+			remote, err := dialer.Dial(ctx, remotePort)
+			if err != nil {
+				fmt.Fprintf(logOut, "error dialing port %d: %v\n", remotePort, err)
+				return
+			}
+			defer remote.Close()
+
+			done := make(chan struct{}, 2)
+			go func() {
+				_, _ = io.Copy(remote, conn)
+				done <- struct{}{}
+			}()
+			go func() {
+				_, _ = io.Copy(conn, remote)
+				done <- struct{}{}
+			}()
+
+			select {
+			case <-done:
+			case <-ctx.Done():
+			}
+		}()
+	}
+}
+
+// ListenTCPRange tries each port from start through end inclusive and returns
+// a listener on the first one that binds, or an error if the whole range is busy.
+func ListenTCPRange(start, end int, allInterfaces bool) (*net.TCPListener, int, error) {
+	if start <= 0 || end < start {
+		return nil, 0, fmt.Errorf("invalid port range %d-%d", start, end)
+	}
+
+	var lastErr error
+	for port := start; port <= end; port++ {
+		listener, boundPort, err := ListenTCP(port, allInterfaces)
+		if err == nil {
+			return listener, boundPort, nil
+		}
+		lastErr = err
+	}
+
+	return nil, 0, fmt.Errorf("no free port in range %d-%d: %w", start, end, lastErr)
+}
@@ -0,0 +1,397 @@
+package codespaces
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/cli/cli/v2/internal/codespaces/api"
+)
+
+type fakeAPIClient struct {
+	states              []*api.Codespace
+	getCodespaceCalls   int
+	startCodespaceCalls int
+}
+
+func (c *fakeAPIClient) GetCodespace(ctx context.Context, name string, includeConnection bool) (*api.Codespace, error) {
+	index := c.getCodespaceCalls
+	if index >= len(c.states) {
+		index = len(c.states) - 1
+	}
+	c.getCodespaceCalls++
+	return c.states[index], nil
+}
+
+func (c *fakeAPIClient) StartCodespace(ctx context.Context, name string) error {
+	c.startCodespaceCalls++
+	return nil
+}
+
+func (c *fakeAPIClient) HTTPClient() (*http.Client, error) {
+	return http.DefaultClient, nil
+}
+
+type fakeProgressIndicator struct {
+	labels []string
+}
+
+func (p *fakeProgressIndicator) StartProgressIndicatorWithLabel(s string) {
+	p.labels = append(p.labels, s)
+}
+
+func (p *fakeProgressIndicator) StopProgressIndicator() {}
+
+func codespaceWithState(state string) *api.Codespace {
+	return &api.Codespace{
+		Name:  "codespace-name",
+		State: state,
+	}
+}
+
+func readyCodespace() *api.Codespace {
+	codespace := codespaceWithState(api.CodespaceStateAvailable)
+	codespace.Connection = api.CodespaceConnection{
+		TunnelProperties: api.TunnelProperties{
+			ConnectAccessToken:     "connect-token",
+			ManagePortsAccessToken: "manage-ports-token",
+			ServiceUri:             "https://tunnels.example.com",
+			TunnelId:               "tunnel-id",
+			ClusterId:              "cluster-id",
+			Domain:                 "tunnels.example.com",
+		},
+	}
+	return codespace
+}
+
+func TestWaitUntilCodespaceConnectionReadyLogsStateTransitions(t *testing.T) {
+	apiClient := &fakeAPIClient{
+		states: []*api.Codespace{
+			codespaceWithState("Queued"),
+			codespaceWithState("Queued"),
+			codespaceWithState("Provisioning"),
+			readyCodespace(),
+		},
+	}
+	progress := &fakeProgressIndicator{}
+
+	_, _, err := waitUntilCodespaceConnectionReady(context.Background(), progress, apiClient, codespaceWithState("Queued"))
+	if err != nil {
+		t.Fatalf("expected %v, got %v", nil, err)
+	}
+
+	wantTransitions := []string{
+		"Codespace state: Queued → Provisioning",
+		"Codespace state: Provisioning → Available",
+	}
+	for _, want := range wantTransitions {
+		seen := 0
+		for _, label := range progress.labels {
+			if label == want {
+				seen++
+			}
+		}
+		if seen != 1 {
+			t.Errorf("expected transition %q to be logged once, got %d", want, seen)
+		}
+	}
+}
+
+func TestWaitUntilCodespaceConnectionReadyAlreadyAvailable(t *testing.T) {
+	apiClient := &fakeAPIClient{
+		states: []*api.Codespace{readyCodespace()},
+	}
+	progress := &fakeProgressIndicator{}
+
+	_, startedCold, err := waitUntilCodespaceConnectionReady(context.Background(), progress, apiClient, readyCodespace())
+	if err != nil {
+		t.Fatalf("expected %v, got %v", nil, err)
+	}
+	if startedCold {
+		t.Error("expected startedCold to be false for an available codespace")
+	}
+	if apiClient.startCodespaceCalls != 0 {
+		t.Errorf("expected no StartCodespace calls, got %d", apiClient.startCodespaceCalls)
+	}
+}
+
+func TestWaitUntilCodespaceConnectionReadyColdStart(t *testing.T) {
+	apiClient := &fakeAPIClient{
+		states: []*api.Codespace{readyCodespace()},
+	}
+	progress := &fakeProgressIndicator{}
+
+	_, startedCold, err := waitUntilCodespaceConnectionReady(context.Background(), progress, apiClient, codespaceWithState("Shutdown"))
+	if err != nil {
+		t.Fatalf("expected %v, got %v", nil, err)
+	}
+	if !startedCold {
+		t.Error("expected startedCold to be true when the codespace had to be started")
+	}
+	if apiClient.startCodespaceCalls != 1 {
+		t.Errorf("expected exactly 1 StartCodespace call, got %d", apiClient.startCodespaceCalls)
+	}
+}
+
+func TestWaitUntilReadyTimeout(t *testing.T) {
+	apiClient := &fakeAPIClient{
+		states: []*api.Codespace{
+			codespaceWithState("Queued"),
+		},
+	}
+	progress := &fakeProgressIndicator{}
+
+	start := time.Now()
+	_, err := WaitUntilReady(context.Background(), progress, apiClient, codespaceWithState("Queued"), 100*time.Millisecond)
+	if err == nil {
+		t.Fatal("expected an error, got nil")
+	}
+	if want := "timed out while waiting for the codespace to start"; err.Error() != want {
+		t.Errorf("expected error %q, got %q", want, err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("expected the wait to be bounded by the timeout, took %s", elapsed)
+	}
+}
+
+type echoDialer struct {
+	dialedPort int
+}
+
+func (d *echoDialer) Dial(ctx context.Context, port int) (io.ReadWriteCloser, error) {
+	d.dialedPort = port
+	local, remote := net.Pipe()
+	go func() {
+		defer remote.Close()
+		_, _ = io.Copy(remote, remote)
+	}()
+	return local, nil
+}
+
+func TestForwardPortRoundTrip(t *testing.T) {
+	listener, port, err := ListenTCP(0, false)
+	if err != nil {
+		t.Fatalf("expected to bind a port, got %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	dialer := &echoDialer{}
+	logOut := &bytes.Buffer{}
+	forwardErr := make(chan error, 1)
+	go func() {
+		forwardErr <- ForwardPort(ctx, dialer, listener, 8080, logOut)
+	}()
+
+	conn, err := net.Dial("tcp", fmt.Sprintf("127.0.0.1:%d", port))
+	if err != nil {
+		t.Fatalf("expected to dial the forwarded port, got %v", err)
+	}
+
+	want := "hello, codespace"
+	if _, err := conn.Write([]byte(want)); err != nil {
+		t.Fatalf("expected write to succeed, got %v", err)
+	}
+	got := make([]byte, len(want))
+	if _, err := io.ReadFull(conn, got); err != nil {
+		t.Fatalf("expected echoed bytes, got %v", err)
+	}
+	if string(got) != want {
+		t.Errorf("expected %q to round-trip, got %q", want, got)
+	}
+	conn.Close()
+
+	cancel()
+	if err := <-forwardErr; !errors.Is(err, context.Canceled) {
+		t.Errorf("expected context.Canceled after cancellation, got %v", err)
+	}
+
+	if dialer.dialedPort != 8080 {
+		t.Errorf("expected remote port 8080 to be dialed, got %d", dialer.dialedPort)
+	}
+}
+
+func TestListenTCPRangeSkipsBusyPort(t *testing.T) {
+	// occupy an arbitrary free port, then ask for a range starting at it
+	busy, busyPort, err := ListenTCP(0, false)
+	if err != nil {
+		t.Fatalf("expected to bind a port, got %v", err)
+	}
+	defer busy.Close()
+
+	listener, port, err := ListenTCPRange(busyPort, busyPort+10, false)
+	if err != nil {
+		t.Fatalf("expected a listener, got %v", err)
+	}
+	defer listener.Close()
+
+	if port == busyPort {
+		t.Errorf("expected the busy port %d to be skipped", busyPort)
+	}
+	if port < busyPort || port > busyPort+10 {
+		t.Errorf("expected a port in range %d-%d, got %d", busyPort, busyPort+10, port)
+	}
+}
+
+func TestListenTCPRangeInvalid(t *testing.T) {
+	_, _, err := ListenTCPRange(9000, 8000, false)
+	if err == nil {
+		t.Fatal("expected an error, got nil")
+	}
+	if want := "invalid port range 9000-8000"; err.Error() != want {
+		t.Errorf("expected error %q, got %q", want, err)
+	}
+}
+
+func TestWaitUntilCodespaceConnectionReadyMaxAttempts(t *testing.T) {
+	apiClient := &fakeAPIClient{
+		states: []*api.Codespace{
+			codespaceWithState("Queued"),
+		},
+	}
+	progress := &fakeProgressIndicator{}
+
+	opts := ConnectOptions{MaxAttempts: 3}
+	_, _, err := waitUntilCodespaceConnectionReady(context.Background(), progress, apiClient, codespaceWithState("Queued"), opts)
+	if err == nil {
+		t.Fatal("expected an error, got nil")
+	}
+	if want := "gave up after 3 attempts"; err.Error() != want {
+		t.Errorf("expected error %q, got %q", want, err)
+	}
+	if apiClient.getCodespaceCalls != 3 {
+		t.Errorf("expected exactly 3 GetCodespace calls, got %d", apiClient.getCodespaceCalls)
+	}
+}
+
+func TestConnectionReadinessCache(t *testing.T) {
+	cache := NewReadinessCache()
+	opts := ConnectOptions{ReadinessCache: cache}
+
+	apiClient := &fakeAPIClient{
+		states: []*api.Codespace{readyCodespace()},
+	}
+	progress := &fakeProgressIndicator{}
+
+	_, _, err := waitUntilCodespaceConnectionReady(context.Background(), progress, apiClient, codespaceWithState("Shutdown"), opts)
+	if err != nil {
+		t.Fatalf("expected %v, got %v", nil, err)
+	}
+	if apiClient.startCodespaceCalls != 1 {
+		t.Fatalf("expected exactly 1 StartCodespace call, got %d", apiClient.startCodespaceCalls)
+	}
+
+	// a second connect within the TTL reuses the readiness result and skips
+	// the start/backoff path entirely
+	codespace, startedCold, err := waitUntilCodespaceConnectionReady(context.Background(), progress, apiClient, codespaceWithState("Shutdown"), opts)
+	if err != nil {
+		t.Fatalf("expected %v, got %v", nil, err)
+	}
+	if startedCold {
+		t.Error("expected startedCold to be false for a cached result")
+	}
+	if !connectionReady(codespace) {
+		t.Error("expected the cached codespace to be connection-ready")
+	}
+	if apiClient.startCodespaceCalls != 1 {
+		t.Errorf("expected no additional StartCodespace calls, got %d", apiClient.startCodespaceCalls)
+	}
+	if calls := apiClient.getCodespaceCalls; calls != 1 {
+		t.Errorf("expected no additional GetCodespace calls, got %d", calls)
+	}
+
+	// invalidation forces the next connect to re-check state
+	cache.Invalidate("codespace-name")
+	_, _, err = waitUntilCodespaceConnectionReady(context.Background(), progress, apiClient, codespaceWithState("Shutdown"), opts)
+	if err != nil {
+		t.Fatalf("expected %v, got %v", nil, err)
+	}
+	if apiClient.startCodespaceCalls != 2 {
+		t.Errorf("expected a StartCodespace call after invalidation, got %d total", apiClient.startCodespaceCalls)
+	}
+}
+
+func TestReadyCacheExpiry(t *testing.T) {
+	cache := newReadinessCache(30 * time.Second)
+	current := time.Unix(1700000000, 0)
+	cache.now = func() time.Time { return current }
+
+	cache.put(readyCodespace())
+	if cache.get("codespace-name") == nil {
+		t.Fatal("expected a cache hit within the TTL")
+	}
+
+	current = current.Add(31 * time.Second)
+	if cache.get("codespace-name") != nil {
+		t.Error("expected the entry to expire after the TTL")
+	}
+}
+
+func TestWaitForRebuild(t *testing.T) {
+	apiClient := &fakeAPIClient{
+		states: []*api.Codespace{
+			codespaceWithState(api.CodespaceStateRebuilding),
+			codespaceWithState(api.CodespaceStateRebuilding),
+			readyCodespace(),
+		},
+	}
+	progress := &fakeProgressIndicator{}
+
+	codespace, err := WaitForRebuild(context.Background(), progress, apiClient, "codespace-name", time.Minute)
+	if err != nil {
+		t.Fatalf("expected %v, got %v", nil, err)
+	}
+	if codespace.State != api.CodespaceStateAvailable {
+		t.Errorf("expected state %q, got %q", api.CodespaceStateAvailable, codespace.State)
+	}
+	if apiClient.getCodespaceCalls != 3 {
+		t.Errorf("expected exactly 3 GetCodespace calls, got %d", apiClient.getCodespaceCalls)
+	}
+
+	wantLabels := []string{
+		"Codespace state: " + api.CodespaceStateRebuilding,
+		"Codespace state: " + api.CodespaceStateAvailable,
+	}
+	for _, want := range wantLabels {
+		seen := 0
+		for _, label := range progress.labels {
+			if label == want {
+				seen++
+			}
+		}
+		if seen != 1 {
+			t.Errorf("expected label %q to be surfaced once, got %d", want, seen)
+		}
+	}
+}
+
+func TestWaitForRebuildCancelled(t *testing.T) {
+	apiClient := &fakeAPIClient{
+		states: []*api.Codespace{
+			codespaceWithState(api.CodespaceStateRebuilding),
+		},
+	}
+	progress := &fakeProgressIndicator{}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		cancel()
+	}()
+
+	start := time.Now()
+	_, err := WaitForRebuild(ctx, progress, apiClient, "codespace-name", time.Minute)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected %v, got %v", context.Canceled, err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("expected cancellation to abort polling promptly, took %s", elapsed)
+	}
+}
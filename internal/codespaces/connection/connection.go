@@ -2,12 +2,14 @@ package connection
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"log"
 	"net/http"
 	"net/url"
 	"sync"
+	"time"
 
 	"github.com/cli/cli/v2/internal/codespaces/api"
 	"github.com/microsoft/dev-tunnels/go/tunnels"
@@ -30,6 +32,12 @@ type CodespaceConnection struct {
 	Options                    *tunnels.TunnelRequestOptions
 	Tunnel                     *tunnels.Tunnel
 	AllowedPortPrivacySettings []string
+	// StartedCold reports whether the codespace had to be started before the
+	// connection could be made, as opposed to already being available.
+	StartedCold bool
+	// DialTimeout bounds the tunnel connection attempt so a black-holed
+	// relay endpoint fails promptly instead of hanging. Zero means no bound.
+	DialTimeout time.Duration
 }
 
 // NewCodespaceConnection initializes a connection to a codespace.
@@ -92,8 +100,17 @@ func (c *CodespaceConnection) Connect(ctx context.Context) error {
 		return nil
 	}
 
+	if c.DialTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.DialTimeout)
+		defer cancel()
+	}
+
 	// Connect to the tunnel
 	if err := c.TunnelClient.Client.Connect(ctx, ""); err != nil {
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return fmt.Errorf("timed out connecting to tunnel after %s", c.DialTimeout)
+		}
 		return fmt.Errorf("error connecting to tunnel: %w", err)
 	}
 
@@ -3,7 +3,9 @@ package connection
 import (
 	"context"
 	"reflect"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/cli/cli/v2/internal/codespaces/api"
 	"github.com/microsoft/dev-tunnels/go/tunnels"
@@ -79,3 +81,50 @@ func TestNewCodespaceConnection(t *testing.T) {
 		t.Fatalf("NewCodespaceConnection returned a connection with unexpected allowed port privacy settings: %+v", conn.AllowedPortPrivacySettings)
 	}
 }
+
+func TestConnectDialTimeout(t *testing.T) {
+	ctx := context.Background()
+
+	// Create a mock codespace
+	connection := api.CodespaceConnection{
+		TunnelProperties: api.TunnelProperties{
+			ConnectAccessToken:     "connect-token",
+			ManagePortsAccessToken: "manage-ports-token",
+			ServiceUri:             "http://global.rel.tunnels.api.visualstudio.com/",
+			TunnelId:               "tunnel-id",
+			ClusterId:              "usw2",
+			Domain:                 "domain.com",
+		},
+	}
+	codespace := &api.Codespace{
+		Connection:         connection,
+		RuntimeConstraints: api.RuntimeConstraints{AllowedPortPrivacySettings: []string{"public", "private"}},
+	}
+
+	// Create the mock HTTP client
+	httpClient, err := NewMockHttpClient()
+	if err != nil {
+		t.Fatalf("NewHttpClient returned an error: %v", err)
+	}
+
+	// Create the connection
+	conn, err := NewCodespaceConnection(ctx, codespace, httpClient)
+	if err != nil {
+		t.Fatalf("NewCodespaceConnection returned an error: %v", err)
+	}
+
+	// An already-expired deadline simulates a black-holed relay endpoint:
+	// the dial must fail promptly rather than hang
+	conn.DialTimeout = time.Nanosecond
+	start := time.Now()
+	err = conn.Connect(ctx)
+	if err == nil {
+		t.Fatal("expected Connect to time out, got nil")
+	}
+	if !strings.Contains(err.Error(), "timed out connecting to tunnel after 1ns") {
+		t.Errorf("expected a dial timeout error, got %q", err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("expected the dial to be bounded by the timeout, took %s", elapsed)
+	}
+}
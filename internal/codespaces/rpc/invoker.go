@@ -7,9 +7,11 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"net/url"
 	"os"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/cli/cli/v2/internal/codespaces/portforwarder"
@@ -17,13 +19,22 @@ import (
 	"github.com/cli/cli/v2/internal/codespaces/rpc/jupyter"
 	"github.com/cli/cli/v2/internal/codespaces/rpc/ssh"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/keepalive"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
 )
 
 const (
 	ConnectionTimeout = 5 * time.Second
 	requestTimeout    = 30 * time.Second
+
+	// defaultKeepAliveInterval is how often the client pings the internal
+	// server to keep idle connections from being dropped by intermediate
+	// proxies.
+	defaultKeepAliveInterval = 30 * time.Second
+	keepAliveTimeout         = 10 * time.Second
 )
 
 const (
@@ -36,15 +47,34 @@ const (
 
 type StartSSHServerOptions struct {
 	UserPublicKeyFile string
+
+	// Reuse requests an idempotent start: if an SSH server was already
+	// started through this invoker, its port and user are returned instead
+	// of asking the host to start another one. The host protocol has no
+	// reuse flag yet, so reuse is tracked on the client side.
+	Reuse bool
+}
+
+// StartJupyterServerResult describes a started JupyterLab server. The token
+// is extracted from the server URL when present.
+type StartJupyterServerResult struct {
+	Port      int
+	ServerUrl string
+	Token     string
+	Message   string
 }
 
 type Invoker interface {
 	Close() error
 	StartJupyterServer(ctx context.Context) (int, string, error)
+	StartJupyterServerWithResult(ctx context.Context) (StartJupyterServerResult, error)
 	RebuildContainer(ctx context.Context, full bool) error
 	StartSSHServer(ctx context.Context) (int, string, error)
 	StartSSHServerWithOptions(ctx context.Context, options StartSSHServerOptions) (int, string, error)
+	Ping(ctx context.Context) error
 	KeepAlive()
+	Metrics() map[string][]time.Duration
+	Invoke(ctx context.Context, method string, req, resp proto.Message) error
 }
 
 type invoker struct {
@@ -56,14 +86,110 @@ type invoker struct {
 	sshClient         ssh.SshServerHostClient
 	cancelPF          context.CancelFunc
 	keepAliveOverride bool
+	metrics           callMetrics
+
+	// Details of the SSH server started through this invoker, if any,
+	// so that reuse requests can skip a redundant start RPC.
+	sshMu      sync.Mutex
+	sshPort    int
+	sshUser    string
+	sshStarted bool
+}
+
+// callMetrics records the latency of each RPC call, keyed by full method name.
+type callMetrics struct {
+	mu        sync.Mutex
+	durations map[string][]time.Duration
+}
+
+func (m *callMetrics) record(method string, d time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.durations == nil {
+		m.durations = make(map[string][]time.Duration)
+	}
+	m.durations[method] = append(m.durations[method], d)
+}
+
+func (m *callMetrics) snapshot() map[string][]time.Duration {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	out := make(map[string][]time.Duration, len(m.durations))
+	for method, durations := range m.durations {
+		out[method] = append([]time.Duration(nil), durations...)
+	}
+	return out
+}
+
+// Metrics returns the latencies of the RPC calls made so far, keyed by full method name.
+func (i *invoker) Metrics() map[string][]time.Duration {
+	return i.metrics.snapshot()
+}
+
+// metricsInterceptor times each unary RPC call and records it in the invoker's metrics.
+func (i *invoker) metricsInterceptor(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, grpcInvoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	start := time.Now()
+	err := grpcInvoker(ctx, method, req, reply, cc, opts...)
+	i.metrics.record(method, time.Since(start))
+	return err
+}
+
+// InvokerOption configures optional invoker behavior.
+type InvokerOption func(*invokerConfig)
+
+type invokerConfig struct {
+	keepAliveInterval time.Duration
+	credentials       credentials.TransportCredentials
+}
+
+// WithKeepAliveInterval overrides how often the gRPC connection pings the
+// internal server to keep idle connections healthy.
+func WithKeepAliveInterval(interval time.Duration) InvokerOption {
+	return func(cfg *invokerConfig) {
+		cfg.keepAliveInterval = interval
+	}
+}
+
+// WithTransportCredentials secures the internal channel with the given
+// transport credentials instead of the default insecure ones.
+func WithTransportCredentials(creds credentials.TransportCredentials) InvokerOption {
+	return func(cfg *invokerConfig) {
+		cfg.credentials = creds
+	}
+}
+
+// transportCredentials returns the credentials to dial with, defaulting to
+// insecure credentials since the channel normally runs over the tunnel.
+func (cfg *invokerConfig) transportCredentials() credentials.TransportCredentials {
+	if cfg.credentials != nil {
+		return cfg.credentials
+	}
+	return insecure.NewCredentials()
+}
+
+// keepaliveParams returns the client keepalive configuration for the dial.
+func keepaliveParams(interval time.Duration) keepalive.ClientParameters {
+	if interval <= 0 {
+		interval = defaultKeepAliveInterval
+	}
+	return keepalive.ClientParameters{
+		Time:                interval,
+		Timeout:             keepAliveTimeout,
+		PermitWithoutStream: true,
+	}
 }
 
 // Connects to the internal RPC server and returns a new invoker for it
-func CreateInvoker(ctx context.Context, fwd portforwarder.PortForwarder) (Invoker, error) {
+func CreateInvoker(ctx context.Context, fwd portforwarder.PortForwarder, opts ...InvokerOption) (Invoker, error) {
 	ctx, cancel := context.WithTimeout(ctx, ConnectionTimeout)
 	defer cancel()
 
-	invoker, err := connect(ctx, fwd)
+	cfg := &invokerConfig{}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	invoker, err := connect(ctx, fwd, cfg)
 	if err != nil {
 		return nil, fmt.Errorf("error connecting to internal server: %w", err)
 	}
@@ -72,7 +198,7 @@ func CreateInvoker(ctx context.Context, fwd portforwarder.PortForwarder) (Invoke
 }
 
 // Finds a free port to listen on and creates a new RPC invoker that connects to that port
-func connect(ctx context.Context, fwd portforwarder.PortForwarder) (Invoker, error) {
+func connect(ctx context.Context, fwd portforwarder.PortForwarder, cfg *invokerConfig) (Invoker, error) {
 	listener, err := listenTCP()
 	if err != nil {
 		return nil, err
@@ -115,7 +241,9 @@ func connect(ctx context.Context, fwd portforwarder.PortForwarder) (Invoker, err
 	go func() {
 		// Attempt to connect to the port
 		opts := []grpc.DialOption{
-			grpc.WithTransportCredentials(insecure.NewCredentials()),
+			grpc.WithTransportCredentials(cfg.transportCredentials()),
+			grpc.WithKeepaliveParams(keepaliveParams(cfg.keepAliveInterval)),
+			grpc.WithUnaryInterceptor(invoker.metricsInterceptor),
 		}
 		conn, err = grpc.NewClient(localAddress, opts...)
 		ch <- err // nil if we successfully connected
@@ -165,25 +293,47 @@ func (i *invoker) appendMetadata(ctx context.Context) context.Context {
 
 // Starts a remote JupyterLab server to allow the user to connect to the codespace via JupyterLab in their browser
 func (i *invoker) StartJupyterServer(ctx context.Context) (port int, serverUrl string, err error) {
+	result, err := i.StartJupyterServerWithResult(ctx)
+	if err != nil {
+		return 0, "", err
+	}
+
+	return result.Port, result.ServerUrl, nil
+}
+
+// StartJupyterServerWithResult starts a remote JupyterLab server like
+// StartJupyterServer, but returns the server details as a struct so call
+// sites don't have to juggle a tuple and new fields can be added without
+// breaking them.
+func (i *invoker) StartJupyterServerWithResult(ctx context.Context) (StartJupyterServerResult, error) {
 	ctx = i.appendMetadata(ctx)
 	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
 	defer cancel()
 
 	response, err := i.jupyterClient.GetRunningServer(ctx, &jupyter.GetRunningServerRequest{})
 	if err != nil {
-		return 0, "", fmt.Errorf("failed to invoke JupyterLab RPC: %w", err)
+		return StartJupyterServerResult{}, fmt.Errorf("failed to invoke JupyterLab RPC: %w", err)
 	}
 
 	if !response.Result {
-		return 0, "", fmt.Errorf("failed to start JupyterLab: %s", response.Message)
+		return StartJupyterServerResult{}, fmt.Errorf("failed to start JupyterLab: %s", response.Message)
 	}
 
-	port, err = strconv.Atoi(response.Port)
+	port, err := strconv.Atoi(response.Port)
 	if err != nil {
-		return 0, "", fmt.Errorf("failed to parse JupyterLab port: %w", err)
+		return StartJupyterServerResult{}, fmt.Errorf("failed to parse JupyterLab port: %w", err)
+	}
+
+	result := StartJupyterServerResult{
+		Port:      port,
+		ServerUrl: response.ServerUrl,
+		Message:   response.Message,
+	}
+	if u, err := url.Parse(response.ServerUrl); err == nil {
+		result.Token = u.Query().Get("token")
 	}
 
-	return port, response.ServerUrl, err
+	return result, nil
 }
 
 // Rebuilds the container using cached layers by default or from scratch if full is true
@@ -213,6 +363,12 @@ func (i *invoker) StartSSHServer(ctx context.Context) (int, string, error) {
 
 // Starts a remote SSH server to allow the user to connect to the codespace via SSH
 func (i *invoker) StartSSHServerWithOptions(ctx context.Context, options StartSSHServerOptions) (int, string, error) {
+	if options.Reuse {
+		if port, user, ok := i.runningSSHServer(); ok {
+			return port, user, nil
+		}
+	}
+
 	ctx = i.appendMetadata(ctx)
 	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
 	defer cancel()
@@ -241,9 +397,56 @@ func (i *invoker) StartSSHServerWithOptions(ctx context.Context, options StartSS
 		return 0, "", fmt.Errorf("failed to parse SSH server port: %w", err)
 	}
 
+	i.sshMu.Lock()
+	i.sshPort = port
+	i.sshUser = response.User
+	i.sshStarted = true
+	i.sshMu.Unlock()
+
 	return port, response.User, nil
 }
 
+// runningSSHServer returns the details of the SSH server previously started
+// through this invoker, if there is one.
+func (i *invoker) runningSSHServer() (int, string, bool) {
+	i.sshMu.Lock()
+	defer i.sshMu.Unlock()
+	return i.sshPort, i.sshUser, i.sshStarted
+}
+
+// Invoke calls an arbitrary unary method on the established connection, for
+// server methods that don't have a wrapper yet. The method name must be the
+// full gRPC method name, e.g. "/Codespaces.Grpc.v1.Host/Method".
+//
+// This is an unstable escape hatch: prefer the typed wrappers above, which
+// may change or replace generic invocations without notice.
+func (i *invoker) Invoke(ctx context.Context, method string, req, resp proto.Message) error {
+	ctx = i.appendMetadata(ctx)
+	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+
+	if err := i.conn.Invoke(ctx, method, req, resp); err != nil {
+		return fmt.Errorf("failed to invoke %s: %w", method, err)
+	}
+
+	// Mirror the typed wrappers: any invocation counts as client activity
+	// (no need to throw if we fail to get a response from the server)
+	_ = i.notifyCodespaceOfClientActivity(ctx, connectedEventName)
+
+	return nil
+}
+
+// Ping verifies that the internal channel is usable by issuing a lightweight
+// client activity notification, so callers can fail fast before doing heavy
+// work over the connection.
+func (i *invoker) Ping(ctx context.Context) error {
+	if err := i.notifyCodespaceOfClientActivity(ctx, connectedEventName); err != nil {
+		return fmt.Errorf("failed to ping internal server: %w", err)
+	}
+
+	return nil
+}
+
 func listenTCP() (*net.TCPListener, error) {
 	// We will end up using this same address to connect, so specify the IP also or the connect will fail
 	addr, err := net.ResolveTCPAddr("tcp", "127.0.0.1:0")
@@ -2,16 +2,19 @@ package rpc
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
 	"net"
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/cli/cli/v2/internal/codespaces/rpc/codespace"
 	"github.com/cli/cli/v2/internal/codespaces/rpc/jupyter"
 	"github.com/cli/cli/v2/internal/codespaces/rpc/ssh"
 	rpctest "github.com/cli/cli/v2/internal/codespaces/rpc/test"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 )
 
 type mockServer struct {
@@ -102,6 +105,39 @@ func verifyNotifyCodespaceOfClientActivity(t *testing.T, server *mockServer) {
 	t.Fatalf("no activity named %s", connectedEventName)
 }
 
+// Test that the dial options include the expected keepalive configuration
+func TestKeepaliveParams(t *testing.T) {
+	params := keepaliveParams(0)
+	if params.Time != defaultKeepAliveInterval {
+		t.Errorf("expected default ping interval %v, got %v", defaultKeepAliveInterval, params.Time)
+	}
+	if params.Timeout != keepAliveTimeout {
+		t.Errorf("expected ping timeout %v, got %v", keepAliveTimeout, params.Timeout)
+	}
+	if !params.PermitWithoutStream {
+		t.Error("expected pings to be permitted without active streams")
+	}
+
+	params = keepaliveParams(5 * time.Second)
+	if params.Time != 5*time.Second {
+		t.Errorf("expected overridden ping interval %v, got %v", 5*time.Second, params.Time)
+	}
+}
+
+// Test that provided transport credentials are used for the dial, with insecure as the default
+func TestTransportCredentials(t *testing.T) {
+	cfg := &invokerConfig{}
+	if got := cfg.transportCredentials().Info().SecurityProtocol; got != "insecure" {
+		t.Errorf("expected insecure credentials by default, got %q", got)
+	}
+
+	creds := credentials.NewTLS(&tls.Config{})
+	cfg = &invokerConfig{credentials: creds}
+	if cfg.transportCredentials() != creds {
+		t.Error("expected provided credentials to be used for the dial")
+	}
+}
+
 // Test that the RPC invoker returns the correct port and URL when the JupyterLab server starts successfully
 func TestStartJupyterServerSuccess(t *testing.T) {
 	resp := jupyter.GetRunningServerResponse{
@@ -245,6 +281,29 @@ func TestRebuildContainerFailure(t *testing.T) {
 	}
 }
 
+// Test that the generic Invoke escape hatch can call a host method by its full name
+func TestInvokeGeneric(t *testing.T) {
+	server := newMockServer()
+
+	invoker, stop, err := createTestInvoker(t, server)
+	if err != nil {
+		t.Fatalf("error connecting to internal server: %v", err)
+	}
+	defer stop()
+
+	req := &codespace.NotifyCodespaceOfClientActivityRequest{ClientId: clientName, ClientActivities: []string{"test"}}
+	resp := &codespace.NotifyCodespaceOfClientActivityResponse{}
+	err = invoker.Invoke(context.Background(), "/Codespaces.Grpc.CodespaceHostService.v1.CodespaceHost/NotifyCodespaceOfClientActivity", req, resp)
+	if err != nil {
+		t.Fatalf("expected %v, got %v", nil, err)
+	}
+	if !resp.Result {
+		t.Fatalf("expected a successful response, got %v", resp)
+	}
+
+	verifyNotifyCodespaceOfClientActivity(t, server)
+}
+
 // Test that the RPC invoker returns the correct port and user when the SSH server starts successfully
 func TestStartSSHServerSuccess(t *testing.T) {
 	resp := ssh.StartRemoteServerResponse{
@@ -277,6 +336,15 @@ func TestStartSSHServerSuccess(t *testing.T) {
 	}
 
 	verifyNotifyCodespaceOfClientActivity(t, server)
+
+	metrics := invoker.Metrics()
+	durations := metrics["/Codespaces.Grpc.SshServerHostService.v1.SshServerHost/StartRemoteServerAsync"]
+	if len(durations) != 1 {
+		t.Fatalf("expected 1 recorded latency for the SSH RPC, got %d", len(durations))
+	}
+	if durations[0] < 0 {
+		t.Fatalf("expected a non-negative latency, got %v", durations[0])
+	}
 }
 
 // Test that the RPC invoker returns an error when the SSH server fails to start
@@ -311,3 +379,107 @@ func TestStartSSHServerFailure(t *testing.T) {
 		t.Fatalf("expected %s, got %s", "", user)
 	}
 }
+
+// Test that a reuse request returns the details of an already-running SSH
+// server without asking the host to start another one
+func TestStartSSHServerReuse(t *testing.T) {
+	resp := ssh.StartRemoteServerResponse{
+		ServerPort: strconv.Itoa(1234),
+		User:       "test",
+		Message:    "",
+		Result:     true,
+	}
+
+	server := newMockServer()
+	server.StartRemoteServerAsyncFunc = func(context.Context, *ssh.StartRemoteServerRequest) (*ssh.StartRemoteServerResponse, error) {
+		return &resp, nil
+	}
+
+	invoker, stop, err := createTestInvoker(t, server)
+	if err != nil {
+		t.Fatalf("error connecting to internal server: %v", err)
+	}
+	defer stop()
+
+	port, user, err := invoker.StartSSHServerWithOptions(context.Background(), StartSSHServerOptions{Reuse: true})
+	if err != nil {
+		t.Fatalf("expected %v, got %v", nil, err)
+	}
+
+	reusedPort, reusedUser, err := invoker.StartSSHServerWithOptions(context.Background(), StartSSHServerOptions{Reuse: true})
+	if err != nil {
+		t.Fatalf("expected %v, got %v", nil, err)
+	}
+	if reusedPort != port {
+		t.Fatalf("expected %d, got %d", port, reusedPort)
+	}
+	if reusedUser != user {
+		t.Fatalf("expected %s, got %s", user, reusedUser)
+	}
+
+	if calls := len(server.SshServerHostServerMock.StartRemoteServerAsyncCalls()); calls != 1 {
+		t.Fatalf("expected 1 start RPC, got %d", calls)
+	}
+}
+
+// Test that Ping succeeds against a healthy server and fails once the
+// server has been stopped
+func TestPing(t *testing.T) {
+	server := newMockServer()
+
+	invoker, stop, err := createTestInvoker(t, server)
+	if err != nil {
+		t.Fatalf("error connecting to internal server: %v", err)
+	}
+
+	if err := invoker.Ping(context.Background()); err != nil {
+		t.Fatalf("expected %v, got %v", nil, err)
+	}
+
+	stop()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 1*time.Second)
+	defer cancel()
+	if err := invoker.Ping(ctx); err == nil {
+		t.Fatal("expected an error after the server stopped, got nil")
+	}
+}
+
+// Test that the structured start method reports the full server details,
+// including the token extracted from the server URL
+func TestStartJupyterServerWithResult(t *testing.T) {
+	resp := jupyter.GetRunningServerResponse{
+		Port:      strconv.Itoa(1234),
+		ServerUrl: "http://localhost:1234?token=abcd",
+		Message:   "",
+		Result:    true,
+	}
+
+	server := newMockServer()
+	server.JupyterServerHostServerMock.GetRunningServerFunc = func(context.Context, *jupyter.GetRunningServerRequest) (*jupyter.GetRunningServerResponse, error) {
+		return &resp, nil
+	}
+
+	invoker, stop, err := createTestInvoker(t, server)
+	if err != nil {
+		t.Fatalf("error connecting to internal server: %v", err)
+	}
+	defer stop()
+
+	result, err := invoker.StartJupyterServerWithResult(context.Background())
+	if err != nil {
+		t.Fatalf("expected %v, got %v", nil, err)
+	}
+	if strconv.Itoa(result.Port) != resp.Port {
+		t.Fatalf("expected %s, got %d", resp.Port, result.Port)
+	}
+	if result.ServerUrl != resp.ServerUrl {
+		t.Fatalf("expected %s, got %s", resp.ServerUrl, result.ServerUrl)
+	}
+	if result.Token != "abcd" {
+		t.Fatalf("expected %s, got %s", "abcd", result.Token)
+	}
+	if result.Message != resp.Message {
+		t.Fatalf("expected %s, got %s", resp.Message, result.Message)
+	}
+}
@@ -22,6 +22,20 @@ func Test_repoFromURL(t *testing.T) {
 			host:   "github.com",
 			err:    nil,
 		},
+		{
+			name:   "github.com URL without .git suffix",
+			input:  "https://github.com/monalisa/octo-cat",
+			result: "monalisa/octo-cat",
+			host:   "github.com",
+			err:    nil,
+		},
+		{
+			name:   "github.com URL with .git suffix and trailing slash",
+			input:  "https://github.com/monalisa/octo-cat.git/",
+			result: "monalisa/octo-cat",
+			host:   "github.com",
+			err:    nil,
+		},
 		{
 			name:   "github.com URL with trailing slash",
 			input:  "https://github.com/monalisa/octo-cat/",
@@ -2,7 +2,9 @@ package tableprinter
 
 import (
 	"io"
+	"regexp"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/cli/cli/v2/internal/text"
@@ -12,8 +14,265 @@ import (
 
 type TablePrinter struct {
 	tableprinter.TablePrinter
-	isTTY bool
-	cs    *iostreams.ColorScheme
+	isTTY              bool
+	cs                 *iostreams.ColorScheme
+	renderUTC          bool
+	fieldsInRow        int
+	continuations      map[int][]string
+	footer             []string
+	preserveHeaderCase bool
+	emojiWidths        bool
+	journal            []tableOp
+	newSibling         func(io.Writer) *TablePrinter
+}
+
+// tableOp records a field or row boundary so the table can be replayed to
+// another writer by RenderTo.
+type tableOp struct {
+	endRow  bool
+	wrapped bool
+	value   string
+	width   int
+	color   func(string) string
+	opts    []FieldOption
+}
+
+// FieldOption configures an individual field of a row.
+type FieldOption func(*fieldOptions)
+
+type fieldOptions struct {
+	color       func(string) string
+	padding     func(int, string) string
+	paddingSet  bool
+	truncate    func(int, string) string
+	truncateSet bool
+}
+
+// WithColor sets the color function for the field. The function should
+// transform a string value by wrapping it in ANSI escape codes.
+func WithColor(fn func(string) string) FieldOption {
+	return func(o *fieldOptions) {
+		o.color = fn
+	}
+}
+
+// WithPadding sets the padding function for the field, replacing the default
+// space padding.
+func WithPadding(fn func(int, string) string) FieldOption {
+	return func(o *fieldOptions) {
+		o.padding = fn
+		o.paddingSet = true
+	}
+}
+
+// WithTruncate sets the truncation function for the field, replacing the
+// default truncation. A nil function disables truncation for the field.
+func WithTruncate(fn func(int, string) string) FieldOption {
+	return func(o *fieldOptions) {
+		o.truncate = fn
+		o.truncateSet = true
+	}
+}
+
+// AddField adds a field to the current row.
+func (t *TablePrinter) AddField(s string, opts ...FieldOption) {
+	t.journal = append(t.journal, tableOp{value: s, opts: opts})
+	t.addField(s, opts...)
+}
+
+// ansiRE matches ANSI escape sequences such as colors and cursor movement.
+var ansiRE = regexp.MustCompile("\x1b\\[[0-9;]*[a-zA-Z]")
+
+func (t *TablePrinter) addField(s string, opts ...FieldOption) {
+	if !t.isTTY {
+		// pre-colored values would otherwise leak escape codes into piped
+		// output
+		s = ansiRE.ReplaceAllString(s, "")
+	}
+	var o fieldOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+	if t.emojiWidths && t.isTTY && !o.paddingSet {
+		o.padding = padRightEmoji
+		o.paddingSet = true
+	}
+	switch {
+	case o.paddingSet && o.truncateSet:
+		t.TablePrinter.AddField(s, tableprinter.WithColor(o.color), tableprinter.WithPadding(o.padding), tableprinter.WithTruncate(o.truncate))
+	case o.paddingSet:
+		t.TablePrinter.AddField(s, tableprinter.WithColor(o.color), tableprinter.WithPadding(o.padding))
+	case o.truncateSet:
+		t.TablePrinter.AddField(s, tableprinter.WithColor(o.color), tableprinter.WithTruncate(o.truncate))
+	default:
+		t.TablePrinter.AddField(s, tableprinter.WithColor(o.color))
+	}
+	t.fieldsInRow++
+}
+
+// AddWrappedField in TTY mode wraps the content within width across multiple
+// visual rows, aligning continuation lines under the column. In non-TTY mode
+// it collapses the content to a single truncated line.
+func (t *TablePrinter) AddWrappedField(s string, width int, c func(string) string) {
+	t.journal = append(t.journal, tableOp{wrapped: true, value: s, width: width, color: c})
+	if !t.isTTY {
+		t.addField(text.Truncate(width, strings.Join(strings.Fields(s), " ")), WithColor(c))
+		return
+	}
+
+	lines := wrapText(s, width)
+	if len(lines) == 0 {
+		lines = []string{""}
+	}
+	if len(lines) > 1 {
+		if t.continuations == nil {
+			t.continuations = map[int][]string{}
+		}
+		t.continuations[t.fieldsInRow] = lines[1:]
+	}
+	t.addField(lines[0], WithColor(c))
+}
+
+// EndRow ends the current row, emitting any continuation rows queued by
+// AddWrappedField so wrapped lines stay aligned under their columns.
+func (t *TablePrinter) EndRow() {
+	t.journal = append(t.journal, tableOp{endRow: true})
+	columns := t.fieldsInRow
+	t.TablePrinter.EndRow()
+	t.fieldsInRow = 0
+
+	maxLines := 0
+	for _, lines := range t.continuations {
+		if len(lines) > maxLines {
+			maxLines = len(lines)
+		}
+	}
+	for lineIdx := 0; lineIdx < maxLines; lineIdx++ {
+		for col := 0; col < columns; col++ {
+			cell := ""
+			if lines, ok := t.continuations[col]; ok && lineIdx < len(lines) {
+				cell = lines[lineIdx]
+			}
+			t.TablePrinter.AddField(cell)
+		}
+		t.TablePrinter.EndRow()
+	}
+	t.continuations = nil
+}
+
+// AddFooter queues a summary row that renders after all data rows, aligned
+// with the same computed column widths. In TTY mode it is preceded by a dim
+// rule; in non-TTY mode only the plain footer row is emitted.
+func (t *TablePrinter) AddFooter(cells ...string) {
+	t.footer = cells
+}
+
+// Render renders the table, including any footer queued with AddFooter.
+func (t *TablePrinter) Render() error {
+	if t.footer != nil {
+		if t.isTTY {
+			for range t.footer {
+				t.TablePrinter.AddField("",
+					tableprinter.WithTruncate(func(w int, _ string) string { return strings.Repeat("-", w) }),
+					tableprinter.WithColor(t.cs.Gray),
+				)
+			}
+			t.TablePrinter.EndRow()
+		}
+		for _, cell := range t.footer {
+			t.TablePrinter.AddField(cell)
+		}
+		t.TablePrinter.EndRow()
+		t.footer = nil
+	}
+	return t.TablePrinter.Render()
+}
+
+// RenderTo replays the accumulated rows to an arbitrary writer, leaving the
+// original table untouched so Render can still write to its own stream.
+func (t *TablePrinter) RenderTo(w io.Writer) error {
+	sibling := t.newSibling(w)
+	for _, op := range t.journal {
+		switch {
+		case op.endRow:
+			sibling.EndRow()
+		case op.wrapped:
+			sibling.AddWrappedField(op.value, op.width, op.color)
+		default:
+			sibling.AddField(op.value, op.opts...)
+		}
+	}
+	if t.footer != nil {
+		footer := make([]string, len(t.footer))
+		copy(footer, t.footer)
+		sibling.footer = footer
+	}
+	return sibling.Render()
+}
+
+// RowBuffer collects rows from multiple goroutines for later serial
+// rendering. The TablePrinter itself is not safe for concurrent AddField or
+// EndRow calls; code fetching pages in parallel should buffer rows here and
+// flush once from a single goroutine.
+type RowBuffer struct {
+	mu   sync.Mutex
+	rows [][]BufferedField
+}
+
+// BufferedField is a single cell queued in a RowBuffer.
+type BufferedField struct {
+	Value string
+	Opts  []FieldOption
+}
+
+// Field builds a BufferedField for RowBuffer.AddRow.
+func Field(value string, opts ...FieldOption) BufferedField {
+	return BufferedField{Value: value, Opts: opts}
+}
+
+// AddRow queues a row. It is safe for concurrent use.
+func (b *RowBuffer) AddRow(fields ...BufferedField) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.rows = append(b.rows, fields)
+}
+
+// Flush writes all queued rows to the table printer and clears the buffer.
+// It must be called from a single goroutine.
+func (b *RowBuffer) Flush(t *TablePrinter) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for _, row := range b.rows {
+		for _, f := range row {
+			t.AddField(f.Value, f.Opts...)
+		}
+		t.EndRow()
+	}
+	b.rows = nil
+}
+
+// wrapText word-wraps s to lines no wider than width, preserving existing
+// line breaks. Words longer than width are left on their own line.
+func wrapText(s string, width int) []string {
+	var lines []string
+	for _, paragraph := range strings.Split(s, "\n") {
+		words := strings.Fields(paragraph)
+		if len(words) == 0 {
+			lines = append(lines, "")
+			continue
+		}
+		line := words[0]
+		for _, word := range words[1:] {
+			if len(line)+1+len(word) > width {
+				lines = append(lines, line)
+				line = word
+				continue
+			}
+			line += " " + word
+		}
+		lines = append(lines, line)
+	}
+	return lines
 }
 
 // IsTTY gets whether the TablePrinter will render to a terminal.
@@ -21,52 +280,134 @@ func (t *TablePrinter) IsTTY() bool {
 	return t.isTTY
 }
 
-// AddTimeField in TTY mode displays the fuzzy time difference between now and t.
+// TimeFieldPolicy controls how AddTimeField renders times in TTY mode.
+type TimeFieldPolicy struct {
+	// MaxFuzzyAge is the maximum age for fuzzy rendering; older times render
+	// with AbsoluteFormat instead. Zero keeps fuzzy rendering for all ages.
+	MaxFuzzyAge time.Duration
+	// AbsoluteFormat is the time layout used beyond MaxFuzzyAge.
+	// It defaults to "Jan _2, 2006".
+	AbsoluteFormat string
+}
+
+// AddTimeField in TTY mode displays the fuzzy time difference between now and t,
+// unless an optional policy dictates an absolute format for older times.
 // In non-TTY mode it just displays t with the time.RFC3339 format.
-func (tp *TablePrinter) AddTimeField(now, t time.Time, c func(string) string) {
+func (tp *TablePrinter) AddTimeField(now, t time.Time, c func(string) string, policy ...TimeFieldPolicy) {
+	if tp.renderUTC {
+		now = now.UTC()
+		t = t.UTC()
+	}
 	var tf string
 	if tp.isTTY {
 		tf = text.FuzzyAgo(now, t)
+		if len(policy) > 0 && policy[0].MaxFuzzyAge > 0 && now.Sub(t) > policy[0].MaxFuzzyAge {
+			format := policy[0].AbsoluteFormat
+			if format == "" {
+				format = "Jan _2, 2006"
+			}
+			tf = t.Format(format)
+		}
 	} else {
 		tf = t.Format(time.RFC3339)
 	}
 	tp.AddField(tf, WithColor(c))
 }
 
-var (
-	WithColor    = tableprinter.WithColor
-	WithPadding  = tableprinter.WithPadding
-	WithTruncate = tableprinter.WithTruncate
-)
-
 type headerOption struct {
 	columns []string
 }
 
+// Option configures optional TablePrinter behavior.
+type Option func(*TablePrinter)
+
+// WithUTC converts times to UTC before formatting in AddTimeField, giving
+// reproducible output regardless of the local time zone.
+func WithUTC() Option {
+	return func(tp *TablePrinter) {
+		tp.renderUTC = true
+	}
+}
+
+// WithEmojiWidths pads fields treating emoji as two display cells. Emoji
+// presentation sequences such as a heart with a variation selector count as
+// one cell by default, which misaligns columns in terminals that render them
+// double-width.
+func WithEmojiWidths() Option {
+	return func(tp *TablePrinter) {
+		tp.emojiWidths = true
+	}
+}
+
+// emojiDisplayWidth is like text.DisplayWidth except runes in common emoji
+// ranges always count as two cells and variation selectors as zero.
+func emojiDisplayWidth(s string) int {
+	width := 0
+	for _, r := range s {
+		switch {
+		case r == 0xFE0E || r == 0xFE0F: // variation selectors
+		case (r >= 0x1F300 && r <= 0x1FAFF) || (r >= 0x2600 && r <= 0x27BF) || (r >= 0x2B00 && r <= 0x2BFF):
+			width += 2
+		default:
+			width += text.DisplayWidth(string(r))
+		}
+	}
+	return width
+}
+
+// padRightEmoji pads s with spaces to the given display width, measuring
+// emoji as double-width.
+func padRightEmoji(width int, s string) string {
+	if pad := width - emojiDisplayWidth(s); pad > 0 {
+		return s + strings.Repeat(" ", pad)
+	}
+	return s
+}
+
+// WithHeaderCasing controls whether header columns keep their original casing.
+// By default headers are uppercased for consistency; pass preserve as true for
+// tables that echo user-provided names.
+func WithHeaderCasing(preserve bool) Option {
+	return func(tp *TablePrinter) {
+		tp.preserveHeaderCase = preserve
+	}
+}
+
 // New creates a TablePrinter from an IOStreams.
-func New(ios *iostreams.IOStreams, headers headerOption) *TablePrinter {
+func New(ios *iostreams.IOStreams, headers headerOption, opts ...Option) *TablePrinter {
 	maxWidth := 80
 	isTTY := ios.IsStdoutTTY()
 	if isTTY {
 		maxWidth = ios.TerminalWidth()
 	}
 
-	return NewWithWriter(ios.Out, isTTY, maxWidth, ios.ColorScheme(), headers)
+	return NewWithWriter(ios.Out, isTTY, maxWidth, ios.ColorScheme(), headers, opts...)
 }
 
 // NewWithWriter creates a TablePrinter from a Writer, whether the output is a terminal, the terminal width, and more.
-func NewWithWriter(w io.Writer, isTTY bool, maxWidth int, cs *iostreams.ColorScheme, headers headerOption) *TablePrinter {
+func NewWithWriter(w io.Writer, isTTY bool, maxWidth int, cs *iostreams.ColorScheme, headers headerOption, opts ...Option) *TablePrinter {
 	tp := &TablePrinter{
 		TablePrinter: tableprinter.New(w, isTTY, maxWidth),
 		isTTY:        isTTY,
 		cs:           cs,
 	}
+	tp.newSibling = func(w io.Writer) *TablePrinter {
+		return NewWithWriter(w, isTTY, maxWidth, cs, headers, opts...)
+	}
+	for _, opt := range opts {
+		opt(tp)
+	}
 
 	if isTTY && len(headers.columns) > 0 {
-		// Make sure all headers are uppercase, taking a copy of the headers to avoid modifying the original slice.
-		upperCasedHeaders := make([]string, len(headers.columns))
+		// Make sure all headers are uppercase unless original casing was requested,
+		// taking a copy of the headers to avoid modifying the original slice.
+		casedHeaders := make([]string, len(headers.columns))
 		for i := range headers.columns {
-			upperCasedHeaders[i] = strings.ToUpper(headers.columns[i])
+			if tp.preserveHeaderCase {
+				casedHeaders[i] = headers.columns[i]
+			} else {
+				casedHeaders[i] = strings.ToUpper(headers.columns[i])
+			}
 		}
 
 		// Make sure all header columns are padded - even the last one. Previously, the last header column
@@ -77,10 +418,10 @@ func NewWithWriter(w io.Writer, isTTY bool, maxWidth int, cs *iostreams.ColorSch
 			paddingFunc = text.PadRight
 		}
 
-		tp.AddHeader(
-			upperCasedHeaders,
-			WithPadding(paddingFunc),
-			WithColor(cs.LightGrayUnderline),
+		tp.TablePrinter.AddHeader(
+			casedHeaders,
+			tableprinter.WithPadding(paddingFunc),
+			tableprinter.WithColor(cs.LightGrayUnderline),
 		)
 	}
 
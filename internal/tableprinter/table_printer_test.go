@@ -1,13 +1,233 @@
 package tableprinter_test
 
 import (
+	"bytes"
+	"fmt"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/cli/cli/v2/internal/tableprinter"
 	"github.com/cli/cli/v2/pkg/iostreams"
 	"github.com/stretchr/testify/require"
 )
 
+func TestAddTimeFieldPolicy(t *testing.T) {
+	now := time.Date(2024, 1, 15, 12, 0, 0, 0, time.UTC)
+	policy := tableprinter.TimeFieldPolicy{MaxFuzzyAge: 7 * 24 * time.Hour}
+
+	tests := []struct {
+		name string
+		t    time.Time
+		want string
+	}{
+		{
+			name: "just under the threshold stays fuzzy",
+			t:    now.Add(-7*24*time.Hour + time.Hour),
+			want: "about 6 days ago",
+		},
+		{
+			name: "just over the threshold shows the date",
+			t:    now.Add(-7*24*time.Hour - time.Hour),
+			want: "Jan  8, 2024",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ios, _, stdout, _ := iostreams.Test()
+			ios.SetStdoutTTY(true)
+
+			tp := tableprinter.New(ios, tableprinter.NoHeader)
+			tp.AddTimeField(now, tt.t, nil, policy)
+			tp.EndRow()
+			require.NoError(t, tp.Render())
+			require.Equal(t, tt.want+"\n", stdout.String())
+		})
+	}
+}
+
+func TestAddTimeFieldUTC(t *testing.T) {
+	ios, _, stdout, _ := iostreams.Test()
+
+	now := time.Date(2024, 1, 15, 12, 0, 0, 0, time.UTC)
+	zone := time.FixedZone("UTC-5", -5*60*60)
+
+	tp := tableprinter.New(ios, tableprinter.NoHeader, tableprinter.WithUTC())
+	tp.AddTimeField(now, time.Date(2024, 1, 14, 7, 0, 0, 0, zone), nil)
+	tp.EndRow()
+	require.NoError(t, tp.Render())
+	require.Equal(t, "2024-01-14T12:00:00Z\n", stdout.String())
+}
+
+func TestAddWrappedField(t *testing.T) {
+	ios, _, stdout, _ := iostreams.Test()
+	ios.SetStdoutTTY(true)
+
+	tp := tableprinter.New(ios, tableprinter.NoHeader)
+	tp.AddField("123")
+	tp.AddWrappedField("a description that needs to wrap", 16, nil)
+	tp.EndRow()
+	tp.AddField("456")
+	tp.AddWrappedField("short", 16, nil)
+	tp.EndRow()
+	require.NoError(t, tp.Render())
+
+	want := "123  a description\n" +
+		"     that needs to\n" +
+		"     wrap\n" +
+		"456  short\n"
+	require.Equal(t, want, stdout.String())
+}
+
+func TestAddWrappedFieldNonTTY(t *testing.T) {
+	ios, _, stdout, _ := iostreams.Test()
+
+	tp := tableprinter.New(ios, tableprinter.NoHeader)
+	tp.AddField("123")
+	tp.AddWrappedField("a description\nthat needs to wrap", 16, nil)
+	tp.EndRow()
+	require.NoError(t, tp.Render())
+
+	require.Equal(t, "123\ta description...\n", stdout.String())
+}
+
+func TestAddFooter(t *testing.T) {
+	ios, _, stdout, _ := iostreams.Test()
+	ios.SetStdoutTTY(true)
+
+	tp := tableprinter.New(ios, tableprinter.WithHeader("number", "title"))
+	tp.AddField("123")
+	tp.AddField("a title")
+	tp.EndRow()
+	tp.AddFooter("Total:", "1 item")
+	require.NoError(t, tp.Render())
+
+	want := "NUMBER  TITLE\n" +
+		"123     a title\n" +
+		"------  -------\n" +
+		"Total:  1 item\n"
+	require.Equal(t, want, stdout.String())
+}
+
+func TestAddFooterNonTTY(t *testing.T) {
+	ios, _, stdout, _ := iostreams.Test()
+
+	tp := tableprinter.New(ios, tableprinter.NoHeader)
+	tp.AddField("123")
+	tp.AddField("a title")
+	tp.EndRow()
+	tp.AddFooter("Total:", "1 item")
+	require.NoError(t, tp.Render())
+
+	require.Equal(t, "123\ta title\nTotal:\t1 item\n", stdout.String())
+}
+
+func TestWithEmojiWidths(t *testing.T) {
+	ios, _, stdout, _ := iostreams.Test()
+	ios.SetStdoutTTY(true)
+
+	tp := tableprinter.New(ios, tableprinter.NoHeader, tableprinter.WithEmojiWidths())
+	tp.AddField("\u2764\ufe0f") // heart emoji, 1 rune cluster but 2 cells wide
+	tp.AddField("x")
+	tp.EndRow()
+	tp.AddField("ab")
+	tp.AddField("y")
+	tp.EndRow()
+	require.NoError(t, tp.Render())
+
+	lines := strings.Split(strings.TrimRight(stdout.String(), "\n"), "\n")
+	require.Len(t, lines, 2)
+	// the heart occupies two cells, so it needs only the column gap after it
+	// and the second column stays aligned with the ASCII row below
+	require.Equal(t, "❤️  x", lines[0])
+	require.Equal(t, "ab  y", lines[1])
+}
+
+func TestAddFieldStripsANSIWhenNotTTY(t *testing.T) {
+	ios, _, stdout, _ := iostreams.Test()
+
+	tp := tableprinter.New(ios, tableprinter.NoHeader)
+	tp.AddField("\x1b[0;32mgreen\x1b[0m")
+	tp.AddField("plain")
+	tp.EndRow()
+	require.NoError(t, tp.Render())
+
+	require.Equal(t, "green\tplain\n", stdout.String())
+	require.NotContains(t, stdout.String(), "\x1b")
+}
+
+func TestRenderTo(t *testing.T) {
+	ios, _, stdout, _ := iostreams.Test()
+
+	tp := tableprinter.New(ios, tableprinter.WithHeader("name", "value"))
+	tp.AddField("alpha")
+	tp.AddField("one")
+	tp.EndRow()
+	tp.AddField("beta")
+	tp.AddField("two")
+	tp.EndRow()
+
+	var first, second bytes.Buffer
+	require.NoError(t, tp.RenderTo(&first))
+	require.NoError(t, tp.RenderTo(&second))
+	require.Equal(t, first.String(), second.String())
+	require.Contains(t, first.String(), "alpha")
+	require.Contains(t, first.String(), "two")
+
+	// the original stream is still intact
+	require.NoError(t, tp.Render())
+	require.Equal(t, first.String(), stdout.String())
+}
+
+func TestRowBufferConcurrentAddRow(t *testing.T) {
+	ios, _, stdout, _ := iostreams.Test()
+
+	var buf tableprinter.RowBuffer
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		i := i
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			buf.AddRow(
+				tableprinter.Field(fmt.Sprintf("row-%d", i)),
+				tableprinter.Field("value"),
+			)
+		}()
+	}
+	wg.Wait()
+
+	tp := tableprinter.New(ios, tableprinter.NoHeader)
+	buf.Flush(tp)
+	require.NoError(t, tp.Render())
+
+	lines := strings.Split(strings.TrimRight(stdout.String(), "\n"), "\n")
+	require.Len(t, lines, 8)
+	for _, line := range lines {
+		require.Contains(t, line, "row-")
+		require.Contains(t, line, "value")
+	}
+
+	// a second flush must not repeat rows
+	tp2 := tableprinter.New(ios, tableprinter.NoHeader)
+	buf.Flush(tp2)
+	require.NoError(t, tp2.Render())
+	require.Len(t, strings.Split(strings.TrimRight(stdout.String(), "\n"), "\n"), 8)
+}
+
+func TestWithHeaderCasing(t *testing.T) {
+	ios, _, stdout, _ := iostreams.Test()
+	ios.SetStdoutTTY(true)
+
+	tp := tableprinter.New(ios, tableprinter.WithHeader("Story Points"), tableprinter.WithHeaderCasing(true))
+	tp.AddField("3")
+	tp.EndRow()
+	require.NoError(t, tp.Render())
+
+	require.Equal(t, "Story Points\n3\n", stdout.String())
+}
+
 func TestHeadersAreNotMutated(t *testing.T) {
 	// Given a TTY environment so that headers are included in the table
 	ios, _, _, _ := iostreams.Test()
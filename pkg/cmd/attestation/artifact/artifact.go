@@ -21,7 +21,10 @@ const (
 
 // DigestedArtifact abstracts the software artifact being verified
 type DigestedArtifact struct {
-	URL       string
+	URL string
+	// MediaType is the sniffed content type of local file artifacts, or
+	// "application/octet-stream" when detection is inconclusive.
+	MediaType string
 	digest    string
 	digestAlg string
 	nameRef   name.Reference
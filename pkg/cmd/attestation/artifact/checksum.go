@@ -0,0 +1,47 @@
+package artifact
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// verifyAgainstChecksumFile compares the artifact's computed digest against
+// the entry for its base filename in a checksum file such as SHA256SUMS,
+// where each line is "<hex digest>  <filename>". It errors when the recorded
+// digest differs or when the file has no entry for the artifact.
+func verifyAgainstChecksumFile(artifact *DigestedArtifact, sumsPath string) error {
+	f, err := os.Open(sumsPath)
+	if err != nil {
+		return fmt.Errorf("failed to open checksum file: %v", err)
+	}
+	defer f.Close()
+
+	base := filepath.Base(strings.TrimPrefix(artifact.URL, "file://"))
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		fields := strings.Fields(line)
+		if len(fields) != 2 {
+			continue
+		}
+		// a leading "*" marks binary mode in coreutils checksum files
+		name := filepath.Base(strings.TrimPrefix(fields[1], "*"))
+		if name != base {
+			continue
+		}
+		if !strings.EqualFold(fields[0], artifact.digest) {
+			return fmt.Errorf("checksum mismatch for %s: recorded %s, computed %s", base, fields[0], artifact.digest)
+		}
+		return nil
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("failed to read checksum file: %v", err)
+	}
+	return fmt.Errorf("no checksum entry for %s in %s", base, sumsPath)
+}
@@ -0,0 +1,54 @@
+package artifact
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifyAgainstChecksumFile(t *testing.T) {
+	dir := t.TempDir()
+	filename := filepath.Join(dir, "artifact.bin")
+	require.NoError(t, os.WriteFile(filename, []byte("artifact content"), 0600))
+
+	artifact, err := digestLocalFileArtifact(filename, "sha256")
+	require.NoError(t, err)
+
+	writeSums := func(t *testing.T, content string) string {
+		t.Helper()
+		sumsPath := filepath.Join(t.TempDir(), "SHA256SUMS")
+		require.NoError(t, os.WriteFile(sumsPath, []byte(content), 0600))
+		return sumsPath
+	}
+
+	t.Run("match", func(t *testing.T) {
+		sumsPath := writeSums(t, fmt.Sprintf("%s  artifact.bin\n", artifact.digest))
+		require.NoError(t, verifyAgainstChecksumFile(artifact, sumsPath))
+	})
+
+	t.Run("match in binary mode", func(t *testing.T) {
+		sumsPath := writeSums(t, fmt.Sprintf("%s *artifact.bin\n", artifact.digest))
+		require.NoError(t, verifyAgainstChecksumFile(artifact, sumsPath))
+	})
+
+	t.Run("mismatch", func(t *testing.T) {
+		sumsPath := writeSums(t, "deadbeef  artifact.bin\n")
+		err := verifyAgainstChecksumFile(artifact, sumsPath)
+		require.ErrorContains(t, err, "checksum mismatch for artifact.bin")
+		require.ErrorContains(t, err, "recorded deadbeef")
+	})
+
+	t.Run("missing entry", func(t *testing.T) {
+		sumsPath := writeSums(t, fmt.Sprintf("%s  other.bin\n", artifact.digest))
+		err := verifyAgainstChecksumFile(artifact, sumsPath)
+		require.ErrorContains(t, err, "no checksum entry for artifact.bin")
+	})
+
+	t.Run("unreadable checksum file", func(t *testing.T) {
+		err := verifyAgainstChecksumFile(artifact, filepath.Join(t.TempDir(), "missing"))
+		require.ErrorContains(t, err, "failed to open checksum file")
+	})
+}
@@ -1,25 +1,179 @@
 package artifact
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"io"
+	"net/http"
 	"os"
+	"strconv"
+	"strings"
+	"time"
 
+	"github.com/cenkalti/backoff/v4"
 	"github.com/cli/cli/v2/pkg/cmd/attestation/artifact/digest"
+	"golang.org/x/sync/errgroup"
 )
 
-func digestLocalFileArtifact(filename, digestAlg string) (*DigestedArtifact, error) {
+// maxDownloadAttempts bounds retries when the artifact host responds with a
+// secondary rate limit (HTTP 429).
+const maxDownloadAttempts = 4
+
+// progressReader reports the cumulative number of bytes read to a callback,
+// enabling progress feedback while digesting large artifacts.
+type progressReader struct {
+	r        io.Reader
+	read     int64
+	progress func(bytesRead int64)
+}
+
+func (pr *progressReader) Read(p []byte) (int, error) {
+	n, err := pr.r.Read(p)
+	pr.read += int64(n)
+	pr.progress(pr.read)
+	return n, err
+}
+
+// digestLocalFileArtifact digests the file, optionally reporting progress as
+// bytes are read. A nil or omitted callback leaves behavior unchanged.
+func digestLocalFileArtifact(filename, digestAlg string, progress ...func(bytesRead int64)) (*DigestedArtifact, error) {
+	if err := validateDigestAlg(digestAlg); err != nil {
+		return nil, err
+	}
 	data, err := os.Open(filename)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get open local artifact: %v", err)
 	}
 	defer data.Close()
-	digest, err := digest.CalculateDigestWithAlgorithm(data, digestAlg)
+
+	// sniff the content type from the first 512 bytes, then rewind so the
+	// digest still covers the whole file
+	header := make([]byte, 512)
+	n, err := data.Read(header)
+	if err != nil && err != io.EOF {
+		return nil, fmt.Errorf("failed to read local artifact: %v", err)
+	}
+	mediaType := http.DetectContentType(header[:n])
+	if _, err := data.Seek(0, io.SeekStart); err != nil {
+		return nil, fmt.Errorf("failed to read local artifact: %v", err)
+	}
+
+	var r io.Reader = data
+	if len(progress) > 0 && progress[0] != nil {
+		r = &progressReader{r: data, progress: progress[0]}
+	}
+	digest, err := digest.CalculateDigestWithAlgorithm(r, digestAlg)
 	if err != nil {
 		return nil, fmt.Errorf("failed to calculate local artifact digest: %v", err)
 	}
 	return &DigestedArtifact{
 		URL:       fmt.Sprintf("file://%s", filename),
+		MediaType: mediaType,
 		digest:    digest,
 		digestAlg: digestAlg,
 	}, nil
 }
+
+// digestNamedLocalFileArtifact is digestLocalFileArtifact with a logical
+// subject name recorded as the artifact URL instead of the on-disk path. An
+// empty name keeps the default file:// URL.
+func digestNamedLocalFileArtifact(filename, digestAlg, name string, progress ...func(bytesRead int64)) (*DigestedArtifact, error) {
+	artifact, err := digestLocalFileArtifact(filename, digestAlg, progress...)
+	if err != nil {
+		return nil, err
+	}
+	if name != "" {
+		artifact.URL = name
+	}
+	return artifact, nil
+}
+
+// digestRemoteFileArtifact downloads the artifact at the given URL, streaming
+// the response through the digest calculator without buffering the whole body.
+// The download is cancelled when ctx is cancelled.
+func digestRemoteFileArtifact(ctx context.Context, url, digestAlg string) (*DigestedArtifact, error) {
+	if err := validateDigestAlg(digestAlg); err != nil {
+		return nil, err
+	}
+	bo := backoff.NewExponentialBackOff()
+	var resp *http.Response
+	for attempt := 1; ; attempt++ {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to build artifact request: %v", err)
+		}
+		resp, err = http.DefaultClient.Do(req)
+		if err != nil {
+			return nil, fmt.Errorf("failed to download artifact: %v", err)
+		}
+		if resp.StatusCode == http.StatusTooManyRequests && attempt < maxDownloadAttempts {
+			resp.Body.Close()
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			case <-time.After(retryDelay(resp.Header.Get("Retry-After"), bo)):
+			}
+			continue
+		}
+		break
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to download artifact: unexpected status %s for %s", resp.Status, url)
+	}
+	digest, err := digest.CalculateDigestWithAlgorithm(resp.Body, digestAlg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to calculate remote artifact digest: %v", err)
+	}
+	return &DigestedArtifact{
+		URL:       url,
+		digest:    digest,
+		digestAlg: digestAlg,
+	}, nil
+}
+
+// retryDelay honors a Retry-After header expressed in seconds, falling back
+// to exponential backoff when the header is absent or malformed.
+func retryDelay(retryAfter string, bo backoff.BackOff) time.Duration {
+	if secs, err := strconv.Atoi(retryAfter); err == nil && secs >= 0 {
+		return time.Duration(secs) * time.Second
+	}
+	return bo.NextBackOff()
+}
+
+// validateDigestAlg rejects unsupported digest algorithms before any file I/O.
+func validateDigestAlg(digestAlg string) error {
+	if !digest.IsValidDigestAlgorithm(digestAlg) {
+		return fmt.Errorf("unsupported digest algorithm %q, supported algorithms are: %s", digestAlg, strings.Join(digest.ValidDigestAlgorithms(), ", "))
+	}
+	return nil
+}
+
+// digestLocalFileArtifacts digests the given files with a bounded worker pool,
+// preserving input order in the returned slice. Errors are aggregated so one
+// unreadable file does not hide failures for the others.
+func digestLocalFileArtifacts(filenames []string, digestAlg string, concurrency int) ([]*DigestedArtifact, error) {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	artifacts := make([]*DigestedArtifact, len(filenames))
+	errs := make([]error, len(filenames))
+
+	var g errgroup.Group
+	g.SetLimit(concurrency)
+	for i, filename := range filenames {
+		i, filename := i, filename
+		g.Go(func() error {
+			artifacts[i], errs[i] = digestLocalFileArtifact(filename, digestAlg)
+			return nil
+		})
+	}
+	_ = g.Wait()
+
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+	return artifacts, nil
+}
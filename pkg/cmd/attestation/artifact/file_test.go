@@ -0,0 +1,200 @@
+package artifact
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDigestLocalFileArtifacts(t *testing.T) {
+	dir := t.TempDir()
+	filenames := make([]string, 5)
+	for i := range filenames {
+		filenames[i] = filepath.Join(dir, fmt.Sprintf("artifact-%d.bin", i))
+		require.NoError(t, os.WriteFile(filenames[i], []byte(fmt.Sprintf("content %d", i)), 0600))
+	}
+
+	artifacts, err := digestLocalFileArtifacts(filenames, "sha256", 3)
+	require.NoError(t, err)
+	require.Len(t, artifacts, len(filenames))
+
+	for i, artifact := range artifacts {
+		require.Equal(t, fmt.Sprintf("file://%s", filenames[i]), artifact.URL)
+
+		expected, err := digestLocalFileArtifact(filenames[i], "sha256")
+		require.NoError(t, err)
+		require.Equal(t, expected.digest, artifact.digest)
+	}
+}
+
+func TestDigestWithAlgCanonicalFormat(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "artifact.bin")
+	require.NoError(t, os.WriteFile(filename, []byte("content"), 0600))
+
+	artifact, err := digestLocalFileArtifact(filename, "sha256")
+	require.NoError(t, err)
+	require.Equal(t, fmt.Sprintf("sha256:%s", artifact.Digest()), artifact.DigestWithAlg())
+}
+
+func TestDigestLocalFileArtifactUnsupportedAlgorithm(t *testing.T) {
+	// A nonexistent path proves the algorithm is rejected before any file I/O.
+	_, err := digestLocalFileArtifact(filepath.Join(t.TempDir(), "does-not-exist.bin"), "md5")
+	require.EqualError(t, err, `unsupported digest algorithm "md5", supported algorithms are: sha256, sha512`)
+}
+
+func TestDigestLocalFileArtifactProgress(t *testing.T) {
+	dir := t.TempDir()
+	filename := filepath.Join(dir, "artifact.bin")
+	content := []byte("some artifact content")
+	require.NoError(t, os.WriteFile(filename, content, 0600))
+
+	var lastReported int64
+	artifact, err := digestLocalFileArtifact(filename, "sha256", func(bytesRead int64) {
+		lastReported = bytesRead
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(len(content)), lastReported)
+
+	expected, err := digestLocalFileArtifact(filename, "sha256")
+	require.NoError(t, err)
+	require.Equal(t, expected.digest, artifact.digest)
+}
+
+func TestDigestRemoteFileArtifact(t *testing.T) {
+	content := []byte("remote artifact content")
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(content)
+	}))
+	defer server.Close()
+
+	artifact, err := digestRemoteFileArtifact(context.Background(), server.URL, "sha256")
+	require.NoError(t, err)
+	require.Equal(t, server.URL, artifact.URL)
+
+	local := filepath.Join(t.TempDir(), "artifact.bin")
+	require.NoError(t, os.WriteFile(local, content, 0600))
+	expected, err := digestLocalFileArtifact(local, "sha256")
+	require.NoError(t, err)
+	require.Equal(t, expected.digest, artifact.digest)
+}
+
+func TestDigestNamedLocalFileArtifact(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "artifact.bin")
+	require.NoError(t, os.WriteFile(filename, []byte("artifact content"), 0600))
+
+	named, err := digestNamedLocalFileArtifact(filename, "sha256", "pkg:generic/artifact@1.0.0")
+	require.NoError(t, err)
+	require.Equal(t, "pkg:generic/artifact@1.0.0", named.URL)
+
+	unnamed, err := digestNamedLocalFileArtifact(filename, "sha256", "")
+	require.NoError(t, err)
+	require.Equal(t, fmt.Sprintf("file://%s", filename), unnamed.URL)
+	require.Equal(t, unnamed.digest, named.digest)
+}
+
+func TestDigestLocalFileArtifactMediaType(t *testing.T) {
+	dir := t.TempDir()
+
+	var gzipped bytes.Buffer
+	zw := gzip.NewWriter(&gzipped)
+	_, err := zw.Write([]byte("compressed content"))
+	require.NoError(t, err)
+	require.NoError(t, zw.Close())
+	gzipFile := filepath.Join(dir, "artifact.tar.gz")
+	require.NoError(t, os.WriteFile(gzipFile, gzipped.Bytes(), 0600))
+
+	artifact, err := digestLocalFileArtifact(gzipFile, "sha256")
+	require.NoError(t, err)
+	require.Equal(t, "application/x-gzip", artifact.MediaType)
+
+	binFile := filepath.Join(dir, "artifact.bin")
+	require.NoError(t, os.WriteFile(binFile, []byte{0x00, 0x01, 0x02, 0x03}, 0600))
+
+	artifact, err = digestLocalFileArtifact(binFile, "sha256")
+	require.NoError(t, err)
+	require.Equal(t, "application/octet-stream", artifact.MediaType)
+}
+
+func TestDigestRemoteFileArtifactRetriesRateLimit(t *testing.T) {
+	content := []byte("rate limited artifact")
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		_, _ = w.Write(content)
+	}))
+	defer server.Close()
+
+	artifact, err := digestRemoteFileArtifact(context.Background(), server.URL, "sha256")
+	require.NoError(t, err)
+	require.Equal(t, 2, requests)
+
+	local := filepath.Join(t.TempDir(), "artifact.bin")
+	require.NoError(t, os.WriteFile(local, content, 0600))
+	expected, err := digestLocalFileArtifact(local, "sha256")
+	require.NoError(t, err)
+	require.Equal(t, expected.digest, artifact.digest)
+}
+
+func TestDigestRemoteFileArtifactRateLimitExhaustsAttempts(t *testing.T) {
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Retry-After", "0")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	_, err := digestRemoteFileArtifact(context.Background(), server.URL, "sha256")
+	require.ErrorContains(t, err, "unexpected status 429")
+	require.Equal(t, maxDownloadAttempts, requests)
+}
+
+func TestRetryDelay(t *testing.T) {
+	bo := backoff.NewExponentialBackOff()
+	require.Equal(t, 2*time.Second, retryDelay("2", bo))
+	require.Equal(t, time.Duration(0), retryDelay("0", bo))
+	require.Greater(t, retryDelay("", bo), time.Duration(0))
+	require.Greater(t, retryDelay("soon", bo), time.Duration(0))
+}
+
+func TestDigestRemoteFileArtifactNotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.NotFound(w, r)
+	}))
+	defer server.Close()
+
+	_, err := digestRemoteFileArtifact(context.Background(), server.URL, "sha256")
+	require.ErrorContains(t, err, "unexpected status 404")
+}
+
+func TestDigestLocalFileArtifactsAggregatesErrors(t *testing.T) {
+	dir := t.TempDir()
+	readable := filepath.Join(dir, "readable.bin")
+	require.NoError(t, os.WriteFile(readable, []byte("content"), 0600))
+
+	filenames := []string{
+		filepath.Join(dir, "missing-1.bin"),
+		readable,
+		filepath.Join(dir, "missing-2.bin"),
+	}
+
+	_, err := digestLocalFileArtifacts(filenames, "sha256", 2)
+	require.Error(t, err)
+	require.ErrorContains(t, err, "missing-1.bin")
+	require.ErrorContains(t, err, "missing-2.bin")
+}
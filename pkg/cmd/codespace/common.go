@@ -17,6 +17,7 @@ import (
 	"github.com/AlecAivazis/survey/v2/terminal"
 	clicontext "github.com/cli/cli/v2/context"
 	"github.com/cli/cli/v2/internal/browser"
+	"github.com/cli/cli/v2/internal/codespaces"
 	"github.com/cli/cli/v2/internal/codespaces/api"
 	"github.com/cli/cli/v2/pkg/iostreams"
 	"github.com/spf13/cobra"
@@ -34,21 +35,32 @@ type App struct {
 	executable executable
 	browser    browser.Browser
 	remotes    func() (clicontext.Remotes, error)
+
+	// connectionCache scopes cached codespace readiness results to this
+	// invocation so they cannot leak across sessions.
+	connectionCache *codespaces.ReadinessCache
 }
 
 func NewApp(io *iostreams.IOStreams, exe executable, apiClient apiClient, browser browser.Browser, remotes func() (clicontext.Remotes, error)) *App {
 	errLogger := log.New(io.ErrOut, "", 0)
 
 	return &App{
-		io:         io,
-		apiClient:  apiClient,
-		errLogger:  errLogger,
-		executable: exe,
-		browser:    browser,
-		remotes:    remotes,
+		io:              io,
+		apiClient:       apiClient,
+		errLogger:       errLogger,
+		executable:      exe,
+		browser:         browser,
+		remotes:         remotes,
+		connectionCache: codespaces.NewReadinessCache(),
 	}
 }
 
+// connectOptions returns the connection options shared by every codespace
+// connection this App makes, notably its readiness cache.
+func (a *App) connectOptions() codespaces.ConnectOptions {
+	return codespaces.ConnectOptions{ReadinessCache: a.connectionCache}
+}
+
 // StartProgressIndicatorWithLabel starts a progress indicator with a message.
 func (a *App) StartProgressIndicatorWithLabel(s string) {
 	a.io.StartProgressIndicatorWithLabel(s)
@@ -39,7 +39,7 @@ func (a *App) Jupyter(ctx context.Context, selector *CodespaceSelector) (err err
 		return err
 	}
 
-	codespaceConnection, err := codespaces.GetCodespaceConnection(ctx, a, a.apiClient, codespace)
+	codespaceConnection, err := codespaces.GetCodespaceConnection(ctx, a, a.apiClient, codespace, a.connectOptions())
 	if err != nil {
 		return fmt.Errorf("error connecting to codespace: %w", err)
 	}
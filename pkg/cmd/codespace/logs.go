@@ -42,7 +42,7 @@ func (a *App) Logs(ctx context.Context, selector *CodespaceSelector, follow bool
 		return err
 	}
 
-	codespaceConnection, err := codespaces.GetCodespaceConnection(ctx, a, a.apiClient, codespace)
+	codespaceConnection, err := codespaces.GetCodespaceConnection(ctx, a, a.apiClient, codespace, a.connectOptions())
 	if err != nil {
 		return fmt.Errorf("error connecting to codespace: %w", err)
 	}
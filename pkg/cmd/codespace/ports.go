@@ -57,7 +57,7 @@ func (a *App) ListPorts(ctx context.Context, selector *CodespaceSelector, export
 
 	devContainerCh := getDevContainer(ctx, a.apiClient, codespace)
 
-	codespaceConnection, err := codespaces.GetCodespaceConnection(ctx, a, a.apiClient, codespace)
+	codespaceConnection, err := codespaces.GetCodespaceConnection(ctx, a, a.apiClient, codespace, a.connectOptions())
 	if err != nil {
 		return fmt.Errorf("error connecting to codespace: %w", err)
 	}
@@ -238,7 +238,7 @@ func (a *App) UpdatePortVisibility(ctx context.Context, selector *CodespaceSelec
 		return err
 	}
 
-	codespaceConnection, err := codespaces.GetCodespaceConnection(ctx, a, a.apiClient, codespace)
+	codespaceConnection, err := codespaces.GetCodespaceConnection(ctx, a, a.apiClient, codespace, a.connectOptions())
 	if err != nil {
 		return fmt.Errorf("error connecting to codespace: %w", err)
 	}
@@ -317,7 +317,7 @@ func (a *App) ForwardPorts(ctx context.Context, selector *CodespaceSelector, por
 		return err
 	}
 
-	codespaceConnection, err := codespaces.GetCodespaceConnection(ctx, a, a.apiClient, codespace)
+	codespaceConnection, err := codespaces.GetCodespaceConnection(ctx, a, a.apiClient, codespace, a.connectOptions())
 	if err != nil {
 		return fmt.Errorf("error connecting to codespace: %w", err)
 	}
@@ -3,6 +3,7 @@ package codespace
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/cli/cli/v2/internal/codespaces"
 	"github.com/cli/cli/v2/internal/codespaces/api"
@@ -11,10 +12,14 @@ import (
 	"github.com/spf13/cobra"
 )
 
+// rebuildTimeout caps how long --wait polls for a rebuild to finish.
+const rebuildTimeout = 10 * time.Minute
+
 func newRebuildCmd(app *App) *cobra.Command {
 	var (
 		selector    *CodespaceSelector
 		fullRebuild bool
+		wait        bool
 	)
 
 	rebuildCmd := &cobra.Command{
@@ -25,18 +30,19 @@ preserved. Your codespace will be rebuilt using your working directory's
 dev container. A full rebuild also removes cached Docker images.`,
 		Args: cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return app.Rebuild(cmd.Context(), selector, fullRebuild)
+			return app.Rebuild(cmd.Context(), selector, fullRebuild, wait)
 		},
 	}
 
 	selector = AddCodespaceSelector(rebuildCmd, app.apiClient)
 
 	rebuildCmd.Flags().BoolVar(&fullRebuild, "full", false, "perform a full rebuild")
+	rebuildCmd.Flags().BoolVar(&wait, "wait", false, "wait for the rebuild to complete")
 
 	return rebuildCmd
 }
 
-func (a *App) Rebuild(ctx context.Context, selector *CodespaceSelector, full bool) (err error) {
+func (a *App) Rebuild(ctx context.Context, selector *CodespaceSelector, full bool, wait bool) (err error) {
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
@@ -51,7 +57,7 @@ func (a *App) Rebuild(ctx context.Context, selector *CodespaceSelector, full boo
 		return nil
 	}
 
-	codespaceConnection, err := codespaces.GetCodespaceConnection(ctx, a, a.apiClient, codespace)
+	codespaceConnection, err := codespaces.GetCodespaceConnection(ctx, a, a.apiClient, codespace, a.connectOptions())
 	if err != nil {
 		return fmt.Errorf("error connecting to codespace: %w", err)
 	}
@@ -74,5 +80,16 @@ func (a *App) Rebuild(ctx context.Context, selector *CodespaceSelector, full boo
 	}
 
 	fmt.Fprintf(a.io.Out, "%s is rebuilding\n", codespace.Name)
+
+	// the rebuild invalidates any cached readiness result
+	a.connectionCache.Invalidate(codespace.Name)
+
+	if wait {
+		if _, err := codespaces.WaitForRebuild(ctx, a, a.apiClient, codespace.Name, rebuildTimeout); err != nil {
+			return err
+		}
+		fmt.Fprintf(a.io.Out, "%s has been rebuilt and is available\n", codespace.Name)
+	}
+
 	return nil
 }
@@ -16,7 +16,7 @@ func TestAlreadyRebuildingCodespace(t *testing.T) {
 	app := testingRebuildApp(*rebuildingCodespace)
 	selector := &CodespaceSelector{api: app.apiClient, codespaceName: "rebuildingCodespace"}
 
-	err := app.Rebuild(context.Background(), selector, false)
+	err := app.Rebuild(context.Background(), selector, false, false)
 	if err != nil {
 		t.Errorf("rebuilding a codespace that was already rebuilding: %v", err)
 	}
@@ -69,7 +69,7 @@ func (rr *remoteResolver) Resolver() func() (context.Remotes, error) {
 		sort.Sort(resolvedRemotes)
 
 		// Filter remotes by hosts
-		cachedRemotes := resolvedRemotes.FilterByHosts(hosts)
+		cachedRemotes = resolvedRemotes.FilterByHosts(hosts)
 
 		// Filter again by default host if one is set
 		// For config file default host fallback to cachedRemotes if none match
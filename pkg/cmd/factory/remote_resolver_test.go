@@ -288,3 +288,36 @@ func Test_remoteResolver(t *testing.T) {
 		})
 	}
 }
+
+func Test_remoteResolverCachesRemotes(t *testing.T) {
+	readCalls := 0
+	cfg := &ghmock.ConfigMock{}
+	cfg.AuthenticationFunc = func() gh.AuthConfig {
+		authCfg := &config.AuthConfig{}
+		authCfg.SetHosts([]string{"github.com"})
+		authCfg.SetDefaultHost("github.com", "default")
+		return authCfg
+	}
+
+	rr := &remoteResolver{
+		readRemotes: func() (git.RemoteSet, error) {
+			readCalls++
+			return git.RemoteSet{
+				git.NewRemote("origin", "https://github.com/owner/repo.git"),
+			}, nil
+		},
+		getConfig:     func() (gh.Config, error) { return cfg, nil },
+		urlTranslator: identityTranslator{},
+	}
+
+	resolver := rr.Resolver()
+
+	first, err := resolver()
+	assert.NoError(t, err)
+	second, err := resolver()
+	assert.NoError(t, err)
+
+	// Repeated resolutions must not re-read git remotes (i.e. shell out again).
+	assert.Equal(t, 1, readCalls)
+	assert.Equal(t, first, second)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strconv"
 	"strings"
 
 	"github.com/cli/cli/v2/api"
@@ -32,6 +33,7 @@ type CheckoutOptions struct {
 	Force             bool
 	Detach            bool
 	BranchName        string
+	DryRun            bool
 }
 
 func NewCmdCheckout(f *cmdutil.Factory, runF func(*CheckoutOptions) error) *cobra.Command {
@@ -66,6 +68,7 @@ func NewCmdCheckout(f *cmdutil.Factory, runF func(*CheckoutOptions) error) *cobr
 	cmd.Flags().BoolVarP(&opts.Force, "force", "f", false, "Reset the existing local branch to the latest state of the pull request")
 	cmd.Flags().BoolVarP(&opts.Detach, "detach", "", false, "Checkout PR with a detached HEAD")
 	cmd.Flags().StringVarP(&opts.BranchName, "branch", "b", "", "Local branch name to use (default [the name of the head branch])")
+	cmd.Flags().BoolVar(&opts.DryRun, "dry-run", false, "Print the git commands that would run without executing them")
 
 	return cmd
 }
@@ -130,6 +133,13 @@ func checkoutRun(opts *CheckoutOptions) error {
 		cmdQueue = append(cmdQueue, []string{"submodule", "update", "--init", "--recursive"})
 	}
 
+	if opts.DryRun {
+		for _, args := range cmdQueue {
+			fmt.Fprintf(opts.IO.Out, "git %s\n", strings.Join(args, " "))
+		}
+		return nil
+	}
+
 	err = executeCmds(opts.GitClient, cmdQueue)
 	if err != nil {
 		return err
@@ -169,6 +179,10 @@ func cmdsForExistingRemote(remote *cliContext.Remote, pr *api.PullRequest, opts
 		cmds = append(cmds, []string{"checkout", "-b", localBranch, "--track", remoteBranch})
 	}
 
+	if !opts.Detach {
+		cmds = append(cmds, recordPRNumberCmd(localBranch, pr.Number))
+	}
+
 	return cmds
 }
 
@@ -227,9 +241,17 @@ func cmdsForMissingRemote(pr *api.PullRequest, baseURLOrName, repoHost, defaultB
 		cmds = append(cmds, []string{"config", fmt.Sprintf("branch.%s.merge", localBranch), mergeRef})
 	}
 
+	cmds = append(cmds, recordPRNumberCmd(localBranch, pr.Number))
+
 	return cmds
 }
 
+// recordPRNumberCmd stores the PR number on the local branch so later gh
+// commands can resolve the branch back to its PR without a search.
+func recordPRNumberCmd(branch string, number int) []string {
+	return []string{"config", fmt.Sprintf("branch.%s.gh-pr-number", branch), strconv.Itoa(number)}
+}
+
 func missingMergeConfigForBranch(client *git.Client, b string) bool {
 	mc, err := client.Config(context.Background(), fmt.Sprintf("branch.%s.merge", b))
 	return err != nil || mc == ""
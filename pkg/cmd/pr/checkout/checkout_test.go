@@ -72,6 +72,32 @@ func Test_checkoutRun(t *testing.T) {
 		wantStderr string
 		wantErr    bool
 	}{
+		{
+			name: "dry run prints commands without executing them",
+			opts: &CheckoutOptions{
+				SelectorArg: "123",
+				DryRun:      true,
+				Finder: func() shared.PRFinder {
+					baseRepo, pr := stubPR("OWNER/REPO:master", "OWNER/REPO:feature")
+					finder := shared.NewMockFinder("123", pr, baseRepo)
+					return finder
+				}(),
+				Config: func() (gh.Config, error) {
+					return config.NewBlankConfig(), nil
+				},
+				Branch: func() (string, error) {
+					return "main", nil
+				},
+			},
+			remotes: map[string]string{
+				"origin": "OWNER/REPO",
+			},
+			runStubs: func(cs *run.CommandStubber) {
+				// only the read-only branch existence check runs
+				cs.Register(`git show-ref --verify -- refs/heads/feature`, 1, "")
+			},
+			wantStdout: "git fetch origin +refs/heads/feature:refs/remotes/origin/feature\ngit checkout -b feature --track origin/feature\ngit config branch.feature.gh-pr-number 123\n",
+		},
 		{
 			name: "fork repo was deleted",
 			opts: &CheckoutOptions{
@@ -100,6 +126,7 @@ func Test_checkoutRun(t *testing.T) {
 				cs.Register(`git config branch\.feature\.remote origin`, 0, "")
 				cs.Register(`git config branch\.feature\.pushRemote origin`, 0, "")
 				cs.Register(`git config branch\.feature\.merge refs/pull/123/head`, 0, "")
+				cs.Register(`git config branch\.feature\.gh-pr-number 123`, 0, "")
 			},
 		},
 		{
@@ -126,6 +153,7 @@ func Test_checkoutRun(t *testing.T) {
 				cs.Register(`git show-ref --verify -- refs/heads/foobar`, 1, "")
 				cs.Register(`git fetch origin \+refs/heads/feature:refs/remotes/origin/feature`, 0, "")
 				cs.Register(`git checkout -b foobar --track origin/feature`, 0, "")
+				cs.Register(`git config branch\.foobar\.gh-pr-number 123`, 0, "")
 			},
 		},
 		{
@@ -156,6 +184,7 @@ func Test_checkoutRun(t *testing.T) {
 				cs.Register(`git config branch\.foobar\.remote https://github.com/hubot/REPO.git`, 0, "")
 				cs.Register(`git config branch\.foobar\.pushRemote https://github.com/hubot/REPO.git`, 0, "")
 				cs.Register(`git config branch\.foobar\.merge refs/heads/feature`, 0, "")
+				cs.Register(`git config branch\.foobar\.gh-pr-number 123`, 0, "")
 			},
 		},
 	}
@@ -279,6 +308,7 @@ func TestPRCheckout_sameRepo(t *testing.T) {
 	cs.Register(`git fetch origin \+refs/heads/feature:refs/remotes/origin/feature`, 0, "")
 	cs.Register(`git show-ref --verify -- refs/heads/feature`, 1, "")
 	cs.Register(`git checkout -b feature --track origin/feature`, 0, "")
+	cs.Register(`git config branch\.feature\.gh-pr-number 123`, 0, "")
 
 	output, err := runCommand(http, nil, "master", `123`)
 	assert.NoError(t, err)
@@ -300,6 +330,7 @@ func TestPRCheckout_existingBranch(t *testing.T) {
 	cs.Register(`git show-ref --verify -- refs/heads/feature`, 0, "")
 	cs.Register(`git checkout feature`, 0, "")
 	cs.Register(`git merge --ff-only refs/remotes/origin/feature`, 0, "")
+	cs.Register(`git config branch\.feature\.gh-pr-number 123`, 0, "")
 
 	output, err := runCommand(http, nil, "master", `123`)
 	assert.NoError(t, err)
@@ -332,6 +363,7 @@ func TestPRCheckout_differentRepo_remoteExists(t *testing.T) {
 	cs.Register(`git fetch robot-fork \+refs/heads/feature:refs/remotes/robot-fork/feature`, 0, "")
 	cs.Register(`git show-ref --verify -- refs/heads/feature`, 1, "")
 	cs.Register(`git checkout -b feature --track robot-fork/feature`, 0, "")
+	cs.Register(`git config branch\.feature\.gh-pr-number 123`, 0, "")
 
 	output, err := runCommand(http, remotes, "master", `123`)
 	assert.NoError(t, err)
@@ -356,6 +388,7 @@ func TestPRCheckout_differentRepo(t *testing.T) {
 	cs.Register(`git config branch\.feature\.remote origin`, 0, "")
 	cs.Register(`git config branch\.feature\.pushRemote origin`, 0, "")
 	cs.Register(`git config branch\.feature\.merge refs/pull/123/head`, 0, "")
+	cs.Register(`git config branch\.feature\.gh-pr-number 123`, 0, "")
 
 	output, err := runCommand(http, nil, "master", `123`)
 	assert.NoError(t, err)
@@ -376,6 +409,7 @@ func TestPRCheckout_differentRepo_existingBranch(t *testing.T) {
 	cs.Register(`git fetch origin refs/pull/123/head:feature`, 0, "")
 	cs.Register(`git config branch\.feature\.merge`, 0, "refs/heads/feature\n")
 	cs.Register(`git checkout feature`, 0, "")
+	cs.Register(`git config branch\.feature\.gh-pr-number 123`, 0, "")
 
 	output, err := runCommand(http, nil, "master", `123`)
 	assert.NoError(t, err)
@@ -396,6 +430,7 @@ func TestPRCheckout_detachedHead(t *testing.T) {
 	cs.Register(`git fetch origin refs/pull/123/head:feature`, 0, "")
 	cs.Register(`git config branch\.feature\.merge`, 0, "refs/heads/feature\n")
 	cs.Register(`git checkout feature`, 0, "")
+	cs.Register(`git config branch\.feature\.gh-pr-number 123`, 0, "")
 
 	output, err := runCommand(http, nil, "", `123`)
 	assert.NoError(t, err)
@@ -416,6 +451,7 @@ func TestPRCheckout_differentRepo_currentBranch(t *testing.T) {
 	cs.Register(`git fetch origin refs/pull/123/head`, 0, "")
 	cs.Register(`git config branch\.feature\.merge`, 0, "refs/heads/feature\n")
 	cs.Register(`git merge --ff-only FETCH_HEAD`, 0, "")
+	cs.Register(`git config branch\.feature\.gh-pr-number 123`, 0, "")
 
 	output, err := runCommand(http, nil, "feature", `123`)
 	assert.NoError(t, err)
@@ -456,6 +492,7 @@ func TestPRCheckout_maintainerCanModify(t *testing.T) {
 	cs.Register(`git config branch\.feature\.remote https://github\.com/hubot/REPO\.git`, 0, "")
 	cs.Register(`git config branch\.feature\.pushRemote https://github\.com/hubot/REPO\.git`, 0, "")
 	cs.Register(`git config branch\.feature\.merge refs/heads/feature`, 0, "")
+	cs.Register(`git config branch\.feature\.gh-pr-number 123`, 0, "")
 
 	output, err := runCommand(http, nil, "master", `123`)
 	assert.NoError(t, err)
@@ -476,6 +513,7 @@ func TestPRCheckout_recurseSubmodules(t *testing.T) {
 	cs.Register(`git show-ref --verify -- refs/heads/feature`, 0, "")
 	cs.Register(`git checkout feature`, 0, "")
 	cs.Register(`git merge --ff-only refs/remotes/origin/feature`, 0, "")
+	cs.Register(`git config branch\.feature\.gh-pr-number 123`, 0, "")
 	cs.Register(`git submodule sync --recursive`, 0, "")
 	cs.Register(`git submodule update --init --recursive`, 0, "")
 
@@ -498,6 +536,7 @@ func TestPRCheckout_force(t *testing.T) {
 	cs.Register(`git show-ref --verify -- refs/heads/feature`, 0, "")
 	cs.Register(`git checkout feature`, 0, "")
 	cs.Register(`git reset --hard refs/remotes/origin/feature`, 0, "")
+	cs.Register(`git config branch\.feature\.gh-pr-number 123`, 0, "")
 
 	output, err := runCommand(http, nil, "master", `123 --force`)
 
@@ -65,6 +65,7 @@ type CreateOptions struct {
 	Labels    []string
 	Projects  []string
 	Milestone string
+	Closes    []int
 
 	MaintainerCanModify bool
 	Template            string
@@ -238,6 +239,7 @@ func NewCmdCreate(f *cmdutil.Factory, runF func(*CreateOptions) error) *cobra.Co
 	fl.StringSliceVarP(&opts.Reviewers, "reviewer", "r", nil, "Request reviews from people or teams by their `handle`")
 	fl.StringSliceVarP(&opts.Assignees, "assignee", "a", nil, "Assign people by their `login`. Use \"@me\" to self-assign.")
 	fl.StringSliceVarP(&opts.Labels, "label", "l", nil, "Add labels by `name`")
+	fl.IntSliceVar(&opts.Closes, "closes", nil, "Link an issue by `number` to close when the pull request merges (may be repeated)")
 	fl.StringSliceVarP(&opts.Projects, "project", "p", nil, "Add the pull request to projects by `title`")
 	fl.StringVarP(&opts.Milestone, "milestone", "m", "", "Add the pull request to a milestone by `name`")
 	fl.Bool("no-maintainer-edit", false, "Disable maintainer's ability to modify pull request")
@@ -278,6 +280,9 @@ func createRun(opts *CreateOptions) (err error) {
 			state.Title = opts.Title
 			state.Body = opts.Body
 		}
+		if err = applyCloses(opts, ctx, state); err != nil {
+			return
+		}
 		err = handlePush(*opts, *ctx)
 		if err != nil {
 			return
@@ -301,6 +306,10 @@ func createRun(opts *CreateOptions) (err error) {
 		state.Body = opts.Body
 	}
 
+	if err = applyCloses(opts, ctx, state); err != nil {
+		return
+	}
+
 	existingPR, _, err := opts.Finder.Find(shared.FindOptions{
 		Selector:   ctx.HeadBranchLabel,
 		BaseBranch: ctx.BaseBranch,
@@ -476,6 +485,36 @@ func createRun(opts *CreateOptions) (err error) {
 
 var regexPattern = regexp.MustCompile(`(?m)^`)
 
+// applyCloses appends closing keywords for the --closes issues to the body so
+// GitHub links and auto-closes them on merge. When no title was given, the
+// title is seeded from the first linked issue.
+func applyCloses(opts *CreateOptions, ctx *CreateContext, state *shared.IssueMetadataState) error {
+	if len(opts.Closes) == 0 {
+		return nil
+	}
+
+	closings := make([]string, len(opts.Closes))
+	for i, number := range opts.Closes {
+		closings[i] = fmt.Sprintf("Closes #%d", number)
+	}
+	if state.Body != "" && !strings.HasSuffix(state.Body, "\n") {
+		state.Body += "\n"
+	}
+	if state.Body != "" {
+		state.Body += "\n"
+	}
+	state.Body += strings.Join(closings, "\n") + "\n"
+
+	if !opts.TitleProvided && state.Title == "" {
+		title, err := api.IssueTitleByNumber(ctx.Client, ctx.BaseRepo, opts.Closes[0])
+		if err != nil {
+			return fmt.Errorf("could not resolve issue #%d: %w", opts.Closes[0], err)
+		}
+		state.Title = title
+	}
+	return nil
+}
+
 func initDefaultTitleBody(ctx CreateContext, state *shared.IssueMetadataState, useFirstCommit bool, addBody bool) error {
 	baseRef := ctx.BaseTrackingBranch
 	headRef := ctx.HeadBranch
@@ -27,6 +27,7 @@ type ListOptions struct {
 
 	WebMode      bool
 	LimitResults int
+	GroupByRepo  bool
 	Exporter     cmdutil.Exporter
 
 	State      string
@@ -109,6 +110,7 @@ func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Comman
 	cmd.Flags().StringVarP(&opts.Assignee, "assignee", "a", "", "Filter by assignee")
 	cmd.Flags().StringVarP(&opts.Search, "search", "S", "", "Search pull requests with `query`")
 	cmdutil.NilBoolFlag(cmd, &opts.Draft, "draft", "d", "Filter by draft state")
+	cmd.Flags().BoolVar(&opts.GroupByRepo, "group-by-repo", false, "Group results under per-repository headings")
 	cmdutil.AddJSONFlags(cmd, &opts.Exporter, api.PullRequestFields)
 
 	_ = cmdutil.RegisterBranchCompletionFlags(f.GitClient, cmd, "base", "head")
@@ -125,7 +127,9 @@ var defaultFields = []string{
 	"headRepositoryOwner",
 	"isCrossRepository",
 	"isDraft",
+	"author",
 	"createdAt",
+	"updatedAt",
 }
 
 func listRun(opts *ListOptions) error {
@@ -156,6 +160,9 @@ func listRun(opts *ListOptions) error {
 		Draft:      opts.Draft,
 		Fields:     defaultFields,
 	}
+	if opts.GroupByRepo {
+		filters.Fields = append(filters.Fields, "repository")
+	}
 	if opts.Exporter != nil {
 		filters.Fields = opts.Exporter.Fields()
 	}
@@ -198,47 +205,80 @@ func listRun(opts *ListOptions) error {
 		fmt.Fprintf(opts.IO.Out, "\n%s\n\n", title)
 	}
 
+	if opts.GroupByRepo {
+		cs := opts.IO.ColorScheme()
+		for _, group := range groupPullRequestsByRepo(listResult.PullRequests) {
+			fmt.Fprintf(opts.IO.Out, "%s\n", cs.Bold(group.repo))
+			if err := renderPullRequestTable(opts, group.prs); err != nil {
+				return err
+			}
+			fmt.Fprintln(opts.IO.Out)
+		}
+		return nil
+	}
+
+	return renderPullRequestTable(opts, listResult.PullRequests)
+}
+
+func renderPullRequestTable(opts *ListOptions, prs []api.PullRequest) error {
 	cs := opts.IO.ColorScheme()
-	isTTY := opts.IO.IsStdoutTTY()
+
+	// Non-TTY output is a stable contract for scripts: exactly four
+	// tab-separated columns (number, title, headRefName, url) that must not
+	// change between versions. Richer fields belong in --json.
+	if !opts.IO.IsStdoutTTY() {
+		table := tableprinter.New(opts.IO, tableprinter.NoHeader)
+		for _, pr := range prs {
+			table.AddField(strconv.Itoa(pr.Number))
+			table.AddField(text.RemoveExcessiveWhitespace(pr.Title))
+			table.AddField(pr.HeadLabel())
+			table.AddField(pr.URL)
+			table.EndRow()
+		}
+		return table.Render()
+	}
 
 	headers := []string{
 		"ID",
 		"TITLE",
 		"BRANCH",
+		"AUTHOR",
+		"CREATED AT",
+		"UPDATED AT",
 	}
-	if !isTTY {
-		headers = append(headers, "STATE")
-	}
-	headers = append(headers, "CREATED AT")
 
 	table := tableprinter.New(opts.IO, tableprinter.WithHeader(headers...))
-	for _, pr := range listResult.PullRequests {
-		prNum := strconv.Itoa(pr.Number)
-		if isTTY {
-			prNum = "#" + prNum
-		}
-
-		table.AddField(prNum, tableprinter.WithColor(cs.ColorFromString(shared.ColorForPRState(pr))))
+	for _, pr := range prs {
+		table.AddField("#"+strconv.Itoa(pr.Number), tableprinter.WithColor(cs.ColorFromString(shared.ColorForPRState(pr))))
 		table.AddField(text.RemoveExcessiveWhitespace(pr.Title))
 		table.AddField(pr.HeadLabel(), tableprinter.WithColor(cs.Cyan))
-		if !isTTY {
-			table.AddField(prStateWithDraft(&pr))
-		}
+		table.AddField(pr.Author.Login)
 		table.AddTimeField(opts.Now(), pr.CreatedAt, cs.Gray)
+		table.AddTimeField(opts.Now(), pr.UpdatedAt, cs.Gray)
 		table.EndRow()
 	}
-	err = table.Render()
-	if err != nil {
-		return err
-	}
+	return table.Render()
+}
 
-	return nil
+type repoPullRequests struct {
+	repo string
+	prs  []api.PullRequest
 }
 
-func prStateWithDraft(pr *api.PullRequest) string {
-	if pr.IsDraft && pr.State == "OPEN" {
-		return "DRAFT"
+// groupPullRequestsByRepo buckets pull requests by repository, preserving the
+// order in which each repository was first seen.
+func groupPullRequestsByRepo(prs []api.PullRequest) []repoPullRequests {
+	var groups []repoPullRequests
+	index := map[string]int{}
+	for _, pr := range prs {
+		repo := pr.Repository.NameWithOwner
+		i, ok := index[repo]
+		if !ok {
+			i = len(groups)
+			index[repo] = i
+			groups = append(groups, repoPullRequests{repo: repo})
+		}
+		groups[i].prs = append(groups[i].prs, pr)
 	}
-
-	return pr.State
+	return groups
 }
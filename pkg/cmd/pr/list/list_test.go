@@ -87,10 +87,47 @@ func TestPRList(t *testing.T) {
 
 		Showing 3 of 3 open pull requests in OWNER/REPO
 
-		ID   TITLE                  BRANCH         CREATED AT
-		#32  New feature            feature        about 3 hours ago
-		#29  Fixed bad bug          hubot:bug-fix  about 1 month ago
-		#28  Improve documentation  docs           about 2 years ago
+		ID   TITLE               BRANCH         AUTHOR    CREATED AT      UPDATED AT
+		#32  New feature         feature        monalisa  about 3 hou...  about 3 hou...
+		#29  Fixed bad bug       hubot:bug-fix  hubot     about 1 mon...  about 1 mon...
+		#28  Improve documen...  docs           octocat   about 2 yea...  about 2 yea...
+	`), output.String())
+	assert.Equal(t, ``, output.Stderr())
+}
+
+func TestPRList_groupByRepo(t *testing.T) {
+	http := initFakeHTTP()
+	defer http.Verify(t)
+
+	http.Register(httpmock.GraphQL(`query PullRequestList\b`), httpmock.StringResponse(`
+	{ "data": { "repository": { "pullRequests": {
+		"totalCount": 3,
+		"nodes": [
+			{ "number": 32, "title": "New feature", "headRefName": "feature", "state": "OPEN", "author": { "login": "monalisa" }, "createdAt": "2022-08-24T20:50:00Z", "updatedAt": "2022-08-24T20:50:00Z", "repository": { "nameWithOwner": "OWNER/REPO" } },
+			{ "number": 29, "title": "Fixed bad bug", "headRefName": "bug-fix", "state": "OPEN", "author": { "login": "hubot" }, "createdAt": "2022-07-24T23:50:00Z", "updatedAt": "2022-07-24T23:50:00Z", "repository": { "nameWithOwner": "hubot/REPO" } },
+			{ "number": 28, "title": "Improve documentation", "headRefName": "docs", "state": "OPEN", "author": { "login": "octocat" }, "createdAt": "2022-08-24T20:50:00Z", "updatedAt": "2022-08-24T20:50:00Z", "repository": { "nameWithOwner": "OWNER/REPO" } }
+		],
+		"pageInfo": { "hasNextPage": false }
+	} } } }`))
+
+	output, err := runCommand(http, true, "--group-by-repo")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, heredoc.Doc(`
+
+		Showing 3 of 3 open pull requests in OWNER/REPO
+
+		OWNER/REPO
+		ID   TITLE               BRANCH   AUTHOR    CREATED AT         UPDATED AT
+		#32  New feature         feature  monalisa  about 3 hours ago  about 3 hours ago
+		#28  Improve documen...  docs     octocat   about 3 hours ago  about 3 hours ago
+
+		hubot/REPO
+		ID   TITLE          BRANCH   AUTHOR  CREATED AT         UPDATED AT
+		#29  Fixed bad bug  bug-fix  hubot   about 1 month ago  about 1 month ago
+
 	`), output.String())
 	assert.Equal(t, ``, output.Stderr())
 }
@@ -108,9 +145,11 @@ func TestPRList_nontty(t *testing.T) {
 
 	assert.Equal(t, "", output.Stderr())
 
-	assert.Equal(t, `32	New feature	feature	DRAFT	2022-08-24T20:01:12Z
-29	Fixed bad bug	hubot:bug-fix	OPEN	2022-07-20T19:01:12Z
-28	Improve documentation	docs	MERGED	2020-01-26T19:01:12Z
+	// this schema is a stable contract for scripts; it must not change
+	// between versions
+	assert.Equal(t, `32	New feature	feature	https://github.com/monalisa/hello/pull/32
+29	Fixed bad bug	hubot:bug-fix	https://github.com/monalisa/hello/pull/29
+28	Improve documentation	docs	https://github.com/monalisa/hello/pull/28
 `, output.String())
 }
 
@@ -44,6 +44,7 @@ func NewCmdPR(f *cmdutil.Factory) *cobra.Command {
 	}
 
 	cmdutil.EnableRepoOverride(cmd, f)
+	cmdutil.EnableRemoteOverride(cmd, f)
 
 	cmdutil.AddGroup(cmd, "General commands",
 		cmdList.NewCmdList(f, nil),
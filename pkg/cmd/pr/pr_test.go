@@ -0,0 +1,105 @@
+package pr
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/context"
+	"github.com/cli/cli/v2/git"
+	"github.com/cli/cli/v2/internal/browser"
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/internal/gh"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/internal/run"
+	"github.com/cli/cli/v2/pkg/cmd/pr/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test that --remote on the pr parent command scopes remote resolution for
+// subcommands. Subcommands capture f.Remotes when NewCmdPR constructs them,
+// before flags are parsed, so this exercises the full wiring rather than the
+// override helper in isolation.
+func TestPRRemoteOverride(t *testing.T) {
+	tests := []struct {
+		name       string
+		args       []string
+		wantStdout string
+	}{
+		{
+			name:       "checkout uses the first matching remote by default",
+			args:       []string{"checkout", "123", "--dry-run"},
+			wantStdout: "git fetch origin +refs/heads/feature:refs/remotes/origin/feature\ngit checkout -b feature --track origin/feature\ngit config branch.feature.gh-pr-number 123\n",
+		},
+		{
+			name:       "checkout --remote targets the named remote",
+			args:       []string{"checkout", "123", "--dry-run", "--remote", "upstream"},
+			wantStdout: "git fetch upstream +refs/heads/feature:refs/remotes/upstream/feature\ngit checkout -b feature --track upstream/feature\ngit config branch.feature.gh-pr-number 123\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ios, _, stdout, _ := iostreams.Test()
+
+			reg := &httpmock.Registry{}
+			defer reg.Verify(t)
+
+			factory := &cmdutil.Factory{
+				IOStreams: ios,
+				HttpClient: func() (*http.Client, error) {
+					return &http.Client{Transport: reg}, nil
+				},
+				Config: func() (gh.Config, error) {
+					return config.NewBlankConfig(), nil
+				},
+				Remotes: func() (context.Remotes, error) {
+					return context.Remotes{
+						{
+							Remote: &git.Remote{Name: "origin"},
+							Repo:   ghrepo.New("OWNER", "REPO"),
+						},
+						{
+							Remote: &git.Remote{Name: "upstream"},
+							Repo:   ghrepo.New("OWNER", "REPO"),
+						},
+					}, nil
+				},
+				Branch: func() (string, error) {
+					return "main", nil
+				},
+				GitClient: &git.Client{
+					GhPath:  "some/path/gh",
+					GitPath: "some/path/git",
+				},
+				Browser: &browser.Stub{},
+			}
+
+			shared.RunCommandFinder("123", &api.PullRequest{
+				Number:              123,
+				HeadRefName:         "feature",
+				HeadRepositoryOwner: api.Owner{Login: "OWNER"},
+				HeadRepository:      &api.PRRepository{Name: "REPO"},
+			}, ghrepo.New("OWNER", "REPO"))
+
+			cs, restoreRun := run.Stub()
+			defer restoreRun(t)
+			// only the read-only branch existence check runs in dry-run mode
+			cs.Register(`git show-ref --verify -- refs/heads/feature`, 1, "")
+
+			cmd := NewCmdPR(factory)
+			cmd.SetArgs(tt.args)
+			cmd.SetIn(&bytes.Buffer{})
+			cmd.SetOut(io.Discard)
+			cmd.SetErr(io.Discard)
+
+			_, err := cmd.ExecuteC()
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantStdout, stdout.String())
+		})
+	}
+}
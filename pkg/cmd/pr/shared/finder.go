@@ -318,7 +318,7 @@ func findForBranch(httpClient *http.Client, repo ghrepo.Interface, baseBranch, h
 	fieldSet := set.NewStringSet()
 	fieldSet.AddValues(fields)
 	// these fields are required for filtering below
-	fieldSet.AddValues([]string{"state", "baseRefName", "headRefName", "isCrossRepository", "headRepositoryOwner"})
+	fieldSet.AddValues([]string{"state", "baseRefName", "headRefName", "isCrossRepository", "headRepositoryOwner", "updatedAt"})
 
 	query := fmt.Sprintf(`
 	query PullRequestForBranch($owner: String!, $repo: String!, $headRefName: String!, $states: [PullRequestState!]) {
@@ -349,18 +349,27 @@ func findForBranch(httpClient *http.Client, repo ghrepo.Interface, baseBranch, h
 		return nil, err
 	}
 
-	prs := resp.Repository.PullRequests.Nodes
-	sort.SliceStable(prs, func(a, b int) bool {
-		return prs[a].State == "OPEN" && prs[b].State != "OPEN"
-	})
-
-	for _, pr := range prs {
+	var matches []api.PullRequest
+	for _, pr := range resp.Repository.PullRequests.Nodes {
 		if pr.HeadLabel() == headBranch && (baseBranch == "" || pr.BaseRefName == baseBranch) && (pr.State == "OPEN" || resp.Repository.DefaultBranchRef.Name != headBranch) {
-			return &pr, nil
+			matches = append(matches, pr)
 		}
 	}
 
-	return nil, &NotFoundError{fmt.Errorf("no pull requests found for branch %q", headBranch)}
+	if len(matches) == 0 {
+		return nil, &NotFoundError{fmt.Errorf("no pull requests found for branch %q", headBranch)}
+	}
+
+	// Multiple pull requests can share a head ref, e.g. the same branch opened
+	// against different bases. Prefer open ones, then the most recently updated.
+	sort.SliceStable(matches, func(a, b int) bool {
+		if (matches[a].State == "OPEN") != (matches[b].State == "OPEN") {
+			return matches[a].State == "OPEN"
+		}
+		return matches[a].UpdatedAt.After(matches[b].UpdatedAt)
+	})
+
+	return &matches[0], nil
 }
 
 func preloadPrReviews(httpClient *http.Client, repo ghrepo.Interface, pr *api.PullRequest) error {
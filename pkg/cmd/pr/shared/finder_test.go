@@ -220,6 +220,44 @@ func TestFind(t *testing.T) {
 			wantPR:   13,
 			wantRepo: "https://github.com/OWNER/REPO",
 		},
+		{
+			name: "branch argument with multiple open PRs picks most recently updated",
+			args: args{
+				selector: "blueberries",
+				fields:   []string{"id", "number"},
+				baseRepoFn: func() (ghrepo.Interface, error) {
+					return ghrepo.FromFullName("OWNER/REPO")
+				},
+			},
+			httpStub: func(r *httpmock.Registry) {
+				r.Register(
+					httpmock.GraphQL(`query PullRequestForBranch\b`),
+					httpmock.StringResponse(`{"data":{"repository":{
+						"pullRequests":{"nodes":[
+							{
+								"number": 14,
+								"state": "OPEN",
+								"baseRefName": "dev",
+								"headRefName": "blueberries",
+								"updatedAt": "2023-01-01T00:00:00Z",
+								"isCrossRepository": false,
+								"headRepositoryOwner": {"login":"OWNER"}
+							},
+							{
+								"number": 13,
+								"state": "OPEN",
+								"baseRefName": "main",
+								"headRefName": "blueberries",
+								"updatedAt": "2023-02-01T00:00:00Z",
+								"isCrossRepository": false,
+								"headRepositoryOwner": {"login":"OWNER"}
+							}
+						]}
+					}}}`))
+			},
+			wantPR:   13,
+			wantRepo: "https://github.com/OWNER/REPO",
+		},
 		{
 			name: "no argument reads current branch",
 			args: args{
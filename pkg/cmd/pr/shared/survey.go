@@ -11,6 +11,8 @@ import (
 	"github.com/cli/cli/v2/pkg/cmdutil"
 	"github.com/cli/cli/v2/pkg/iostreams"
 	"github.com/cli/cli/v2/pkg/surveyext"
+	"github.com/cli/safeexec"
+	shellquote "github.com/kballard/go-shellquote"
 )
 
 type Action int
@@ -379,5 +381,35 @@ func InitEditorMode(f *cmdutil.Factory, editorMode bool, webMode bool, canPrompt
 		return false, errors.New("--editor or enabled prefer_editor_prompt configuration are not supported in non-tty mode")
 	}
 
+	if editorMode {
+		if _, err := EditorExecutable(f.Config); err != nil {
+			// a missing editor binary would fail cryptically inside the
+			// editor prompt; the interactive flow still offers browser submission
+			fmt.Fprintf(f.IOStreams.ErrOut, "warning: %v; falling back to interactive prompts\n", err)
+			editorMode = false
+		}
+	}
+
 	return editorMode, nil
 }
+
+// EditorExecutable resolves the user's editor and verifies that its binary
+// exists on PATH, so callers can fall back gracefully instead of failing
+// inside the editor prompt.
+func EditorExecutable(cf func() (gh.Config, error)) (string, error) {
+	editorCommand, err := cmdutil.DetermineEditor(cf)
+	if err != nil {
+		return "", err
+	}
+	if editorCommand == "" {
+		editorCommand = surveyext.DefaultEditorName()
+	}
+	args, err := shellquote.Split(editorCommand)
+	if err != nil || len(args) == 0 {
+		return "", fmt.Errorf("invalid editor command %q", editorCommand)
+	}
+	if _, err := safeexec.LookPath(args[0]); err != nil {
+		return "", fmt.Errorf("editor %q not found", args[0])
+	}
+	return editorCommand, nil
+}
@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/internal/gh"
 	"github.com/cli/cli/v2/internal/ghrepo"
 	"github.com/cli/cli/v2/internal/prompter"
 	"github.com/cli/cli/v2/pkg/iostreams"
@@ -158,3 +160,22 @@ type testEditor struct {
 func (e testEditor) Edit(filename, text string) (string, error) {
 	return e.edit(text)
 }
+
+func TestEditorExecutable(t *testing.T) {
+	cf := func() (gh.Config, error) {
+		return config.NewBlankConfig(), nil
+	}
+
+	t.Run("resolves an editor that exists on PATH", func(t *testing.T) {
+		t.Setenv("GH_EDITOR", "sh -c")
+		editor, err := EditorExecutable(cf)
+		assert.NoError(t, err)
+		assert.Equal(t, "sh -c", editor)
+	})
+
+	t.Run("errors when the editor binary is missing", func(t *testing.T) {
+		t.Setenv("GH_EDITOR", "definitely-not-an-editor-xyz")
+		_, err := EditorExecutable(cf)
+		assert.EqualError(t, err, `editor "definitely-not-an-editor-xyz" not found`)
+	})
+}
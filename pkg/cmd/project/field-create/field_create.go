@@ -1,8 +1,13 @@
 package fieldcreate
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/MakeNowJust/heredoc"
 	"github.com/cli/cli/v2/pkg/cmd/project/shared/client"
@@ -14,13 +19,17 @@ import (
 )
 
 type createFieldOpts struct {
-	name                string
-	dataType            string
-	owner               string
-	singleSelectOptions []string
-	number              int32
-	projectID           string
-	exporter            cmdutil.Exporter
+	name                     string
+	dataType                 string
+	owner                    string
+	singleSelectOptions      []string
+	singleSelectOptionColors []string
+	fromFile                 string
+	clobber                  bool
+	number                   int32
+	timeout                  time.Duration
+	projectID                string
+	exporter                 cmdutil.Exporter
 }
 
 type createFieldConfig struct {
@@ -29,12 +38,39 @@ type createFieldConfig struct {
 	io     *iostreams.IOStreams
 }
 
+// optionColors is the palette GitHub accepts for single-select options,
+// checked locally to avoid an opaque server rejection.
+var optionColors = []string{"GRAY", "BLUE", "GREEN", "YELLOW", "ORANGE", "RED", "PINK", "PURPLE"}
+
+func validateOptionColor(color string) error {
+	for _, c := range optionColors {
+		if color == c {
+			return nil
+		}
+	}
+	return cmdutil.FlagErrorf("invalid color %q, valid values are {%s}", color, strings.Join(optionColors, "|"))
+}
+
+// fieldSpec describes one field in a `--from-file` spec, mirroring the
+// `--name`, `--data-type` and `--single-select-options` flags.
+type fieldSpec struct {
+	Name                string   `json:"name"`
+	DataType            string   `json:"dataType"`
+	SingleSelectOptions []string `json:"singleSelectOptions"`
+}
+
 type createProjectV2FieldMutation struct {
 	CreateProjectV2Field struct {
 		Field queries.ProjectField `graphql:"projectV2Field"`
 	} `graphql:"createProjectV2Field(input:$input)"`
 }
 
+type deleteProjectV2FieldMutation struct {
+	DeleteProjectV2Field struct {
+		Field queries.ProjectField `graphql:"projectV2Field"`
+	} `graphql:"deleteProjectV2Field(input:$input)"`
+}
+
 func NewCmdCreateField(f *cmdutil.Factory, runF func(config createFieldConfig) error) *cobra.Command {
 	opts := createFieldOpts{}
 	createFieldCmd := &cobra.Command{
@@ -46,10 +82,19 @@ func NewCmdCreateField(f *cmdutil.Factory, runF func(config createFieldConfig) e
 
 			# create a field with three options to select from for owner monalisa
 			gh project field-create 1 --owner monalisa --name "new field" --data-type "SINGLE_SELECT" --single-select-options "one,two,three"
+
+			# create several fields from a JSON spec file
+			gh project field-create 1 --owner monalisa --from-file fields.json
 		`),
 		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			client, err := client.New(f)
+			var queryClient *queries.Client
+			var err error
+			if opts.timeout > 0 {
+				queryClient, err = client.NewWithTimeout(f, opts.timeout)
+			} else {
+				queryClient, err = client.New(f)
+			}
 			if err != nil {
 				return err
 			}
@@ -63,13 +108,40 @@ func NewCmdCreateField(f *cmdutil.Factory, runF func(config createFieldConfig) e
 			}
 
 			config := createFieldConfig{
-				client: client,
+				client: queryClient,
 				opts:   opts,
 				io:     f.IOStreams,
 			}
 
-			if config.opts.dataType == "SINGLE_SELECT" && len(config.opts.singleSelectOptions) == 0 {
-				return fmt.Errorf("passing `--single-select-options` is required for SINGLE_SELECT data type")
+			if opts.fromFile != "" {
+				if cmd.Flags().Changed("name") || cmd.Flags().Changed("data-type") || cmd.Flags().Changed("single-select-options") {
+					return cmdutil.FlagErrorf("`--from-file` cannot be combined with `--name`, `--data-type`, or `--single-select-options`")
+				}
+			} else {
+				var missing []string
+				if opts.dataType == "" {
+					missing = append(missing, `"data-type"`)
+				}
+				if opts.name == "" {
+					missing = append(missing, `"name"`)
+				}
+				if len(missing) > 0 {
+					return cmdutil.FlagErrorf("required flag(s) %s not set", strings.Join(missing, ", "))
+				}
+				if opts.dataType == "SINGLE_SELECT" && len(opts.singleSelectOptions) == 0 {
+					return fmt.Errorf("passing `--single-select-options` is required for SINGLE_SELECT data type")
+				}
+			}
+
+			if len(opts.singleSelectOptionColors) > 0 {
+				if len(opts.singleSelectOptionColors) != len(opts.singleSelectOptions) {
+					return cmdutil.FlagErrorf("`--single-select-option-colors` requires one color per `--single-select-options` entry")
+				}
+				for _, color := range opts.singleSelectOptionColors {
+					if err := validateOptionColor(color); err != nil {
+						return err
+					}
+				}
 			}
 
 			// allow testing of the command without actually running it
@@ -84,15 +156,20 @@ func NewCmdCreateField(f *cmdutil.Factory, runF func(config createFieldConfig) e
 	createFieldCmd.Flags().StringVar(&opts.name, "name", "", "Name of the new field")
 	cmdutil.StringEnumFlag(createFieldCmd, &opts.dataType, "data-type", "", "", []string{"TEXT", "SINGLE_SELECT", "DATE", "NUMBER"}, "DataType of the new field.")
 	createFieldCmd.Flags().StringSliceVar(&opts.singleSelectOptions, "single-select-options", []string{}, "Options for SINGLE_SELECT data type")
+	createFieldCmd.Flags().StringSliceVar(&opts.singleSelectOptionColors, "single-select-option-colors", []string{}, "Colors for SINGLE_SELECT options, one per option: {GRAY|BLUE|GREEN|YELLOW|ORANGE|RED|PINK|PURPLE}")
+	createFieldCmd.Flags().StringVar(&opts.fromFile, "from-file", "", "Path to a JSON file describing the fields to create (\"-\" to read from standard input)")
+	createFieldCmd.Flags().BoolVar(&opts.clobber, "clobber", false, "Delete any existing field with the same name before creating")
+	createFieldCmd.Flags().DurationVar(&opts.timeout, "timeout", 0, "Maximum time to wait for API requests (default no timeout)")
 	cmdutil.AddFormatFlags(createFieldCmd, &opts.exporter)
 
-	_ = createFieldCmd.MarkFlagRequired("name")
-	_ = createFieldCmd.MarkFlagRequired("data-type")
-
 	return createFieldCmd
 }
 
 func runCreateField(config createFieldConfig) error {
+	if config.opts.fromFile != "" {
+		return runCreateFieldsFromFile(config)
+	}
+
 	canPrompt := config.io.CanPrompt()
 	owner, err := config.client.NewOwner(canPrompt, config.opts.owner)
 	if err != nil {
@@ -105,6 +182,12 @@ func runCreateField(config createFieldConfig) error {
 	}
 	config.opts.projectID = project.ID
 
+	if config.opts.clobber {
+		if err := clobberExistingField(config, owner); err != nil {
+			return err
+		}
+	}
+
 	query, variables := createFieldArgs(config)
 
 	err = config.client.Mutate("CreateField", query, variables)
@@ -119,6 +202,109 @@ func runCreateField(config createFieldConfig) error {
 	return printResults(config, query.CreateProjectV2Field.Field)
 }
 
+// runCreateFieldsFromFile resolves the project once and creates each field in
+// the spec file, reporting per-field success or failure.
+func runCreateFieldsFromFile(config createFieldConfig) error {
+	specs, err := readFieldSpecs(config.opts.fromFile, config.io.In)
+	if err != nil {
+		return err
+	}
+
+	canPrompt := config.io.CanPrompt()
+	owner, err := config.client.NewOwner(canPrompt, config.opts.owner)
+	if err != nil {
+		return err
+	}
+
+	project, err := config.client.NewProject(canPrompt, owner, config.opts.number, false)
+	if err != nil {
+		return err
+	}
+	config.opts.projectID = project.ID
+
+	var errs []error
+	for _, spec := range specs {
+		fieldConfig := config
+		fieldConfig.opts.name = spec.Name
+		fieldConfig.opts.dataType = spec.DataType
+		fieldConfig.opts.singleSelectOptions = spec.SingleSelectOptions
+
+		if config.opts.clobber {
+			if err := clobberExistingField(fieldConfig, owner); err != nil {
+				errs = append(errs, fmt.Errorf("failed to create field %q: %w", spec.Name, err))
+				continue
+			}
+		}
+
+		query, variables := createFieldArgs(fieldConfig)
+		if err := config.client.Mutate("CreateField", query, variables); err != nil {
+			errs = append(errs, fmt.Errorf("failed to create field %q: %w", spec.Name, err))
+			continue
+		}
+		if config.io.IsStdoutTTY() {
+			if _, err := fmt.Fprintf(config.io.Out, "Created field %q\n", spec.Name); err != nil {
+				return err
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// clobberExistingField deletes any existing field that shares a name with the
+// one about to be created. A missing field is not an error.
+func clobberExistingField(config createFieldConfig, owner *queries.Owner) error {
+	existing, err := config.client.ProjectFieldByName(owner, config.opts.number, config.opts.name)
+	if err != nil {
+		if errors.Is(err, queries.ErrFieldNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	query := &deleteProjectV2FieldMutation{}
+	variables := map[string]interface{}{
+		"input": githubv4.DeleteProjectV2FieldInput{
+			FieldID: githubv4.ID(existing.ID()),
+		},
+	}
+	return config.client.Mutate("DeleteField", query, variables)
+}
+
+// readFieldSpecs reads and validates a `--from-file` spec, applying the same
+// rules as the flag path before any field is created.
+func readFieldSpecs(filename string, stdin io.ReadCloser) ([]fieldSpec, error) {
+	b, err := cmdutil.ReadFile(filename, stdin)
+	if err != nil {
+		return nil, err
+	}
+
+	var specs []fieldSpec
+	if err := json.Unmarshal(b, &specs); err != nil {
+		return nil, fmt.Errorf("invalid field spec file: %w", err)
+	}
+	if len(specs) == 0 {
+		return nil, errors.New("field spec file contains no fields")
+	}
+
+	for _, spec := range specs {
+		if spec.Name == "" {
+			return nil, errors.New("each field in the spec file requires a name")
+		}
+		switch spec.DataType {
+		case "TEXT", "DATE", "NUMBER":
+		case "SINGLE_SELECT":
+			if len(spec.SingleSelectOptions) == 0 {
+				return nil, fmt.Errorf("field %q: singleSelectOptions is required for SINGLE_SELECT data type", spec.Name)
+			}
+		default:
+			return nil, fmt.Errorf("field %q: invalid dataType %q, valid values are {TEXT|SINGLE_SELECT|DATE|NUMBER}", spec.Name, spec.DataType)
+		}
+	}
+
+	return specs, nil
+}
+
 func createFieldArgs(config createFieldConfig) (*createProjectV2FieldMutation, map[string]interface{}) {
 	input := githubv4.CreateProjectV2FieldInput{
 		ProjectID: githubv4.ID(config.opts.projectID),
@@ -128,10 +314,14 @@ func createFieldArgs(config createFieldConfig) (*createProjectV2FieldMutation, m
 
 	if len(config.opts.singleSelectOptions) != 0 {
 		opts := make([]githubv4.ProjectV2SingleSelectFieldOptionInput, 0)
-		for _, opt := range config.opts.singleSelectOptions {
+		for i, opt := range config.opts.singleSelectOptions {
+			color := "GRAY"
+			if i < len(config.opts.singleSelectOptionColors) {
+				color = config.opts.singleSelectOptionColors[i]
+			}
 			opts = append(opts, githubv4.ProjectV2SingleSelectFieldOptionInput{
 				Name:  githubv4.String(opt),
-				Color: githubv4.ProjectV2SingleSelectFieldOptionColor("GRAY"),
+				Color: githubv4.ProjectV2SingleSelectFieldOptionColor(color),
 			})
 		}
 		input.SingleSelectOptions = &opts
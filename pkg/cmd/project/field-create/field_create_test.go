@@ -1,6 +1,8 @@
 package fieldcreate
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/cli/cli/v2/pkg/cmd/project/shared/queries"
@@ -42,38 +44,81 @@ func TestNewCmdCreateField(t *testing.T) {
 			name: "number",
 			cli:  "123 --name n --data-type TEXT",
 			wants: createFieldOpts{
-				number:              123,
-				name:                "n",
-				dataType:            "TEXT",
-				singleSelectOptions: []string{},
+				number:                   123,
+				name:                     "n",
+				dataType:                 "TEXT",
+				singleSelectOptions:      []string{},
+				singleSelectOptionColors: []string{},
 			},
 		},
 		{
 			name: "owner",
 			cli:  "--owner monalisa --name n --data-type TEXT",
 			wants: createFieldOpts{
-				owner:               "monalisa",
-				name:                "n",
-				dataType:            "TEXT",
-				singleSelectOptions: []string{},
+				owner:                    "monalisa",
+				name:                     "n",
+				dataType:                 "TEXT",
+				singleSelectOptions:      []string{},
+				singleSelectOptionColors: []string{},
 			},
 		},
 		{
 			name: "single-select-options",
 			cli:  "--name n --data-type TEXT --single-select-options a,b",
 			wants: createFieldOpts{
-				singleSelectOptions: []string{"a", "b"},
-				name:                "n",
-				dataType:            "TEXT",
+				singleSelectOptions:      []string{"a", "b"},
+				singleSelectOptionColors: []string{},
+				name:                     "n",
+				dataType:                 "TEXT",
+			},
+		},
+		{
+			name:        "invalid-option-color",
+			cli:         "123 --name n --data-type SINGLE_SELECT --single-select-options a --single-select-option-colors MAGENTA",
+			wantsErr:    true,
+			wantsErrMsg: `invalid color "MAGENTA", valid values are {GRAY|BLUE|GREEN|YELLOW|ORANGE|RED|PINK|PURPLE}`,
+		},
+		{
+			name:        "option-color-count-mismatch",
+			cli:         "123 --name n --data-type SINGLE_SELECT --single-select-options a,b --single-select-option-colors RED",
+			wantsErr:    true,
+			wantsErrMsg: "`--single-select-option-colors` requires one color per `--single-select-options` entry",
+		},
+		{
+			name: "option-colors",
+			cli:  "123 --name n --data-type SINGLE_SELECT --single-select-options a,b --single-select-option-colors RED,BLUE",
+			wants: createFieldOpts{
+				number:                   123,
+				name:                     "n",
+				dataType:                 "SINGLE_SELECT",
+				singleSelectOptions:      []string{"a", "b"},
+				singleSelectOptionColors: []string{"RED", "BLUE"},
+			},
+		},
+		{
+			name:        "from-file-with-flags",
+			cli:         "123 --from-file fields.json --name n --data-type TEXT",
+			wantsErr:    true,
+			wantsErrMsg: "`--from-file` cannot be combined with `--name`, `--data-type`, or `--single-select-options`",
+		},
+		{
+			name: "from-file",
+			cli:  "123 --from-file fields.json",
+			wants: createFieldOpts{
+				number:                   123,
+				fromFile:                 "fields.json",
+				singleSelectOptions:      []string{},
+				singleSelectOptionColors: []string{},
 			},
 		},
 		{
 			name: "json",
 			cli:  "--format json --name n --data-type TEXT ",
 			wants: createFieldOpts{
-				name:                "n",
-				dataType:            "TEXT",
-				singleSelectOptions: []string{},
+				name:                     "n",
+				dataType:                 "TEXT",
+				singleSelectOptions:      []string{},
+				singleSelectOptionColors: []string{},
 			},
 			wantsExporter: true,
 		},
@@ -111,6 +156,7 @@ func TestNewCmdCreateField(t *testing.T) {
 			assert.Equal(t, tt.wants.name, gotOpts.name)
 			assert.Equal(t, tt.wants.dataType, gotOpts.dataType)
 			assert.Equal(t, tt.wants.singleSelectOptions, gotOpts.singleSelectOptions)
+			assert.Equal(t, tt.wants.singleSelectOptionColors, gotOpts.singleSelectOptionColors)
 			assert.Equal(t, tt.wantsExporter, gotOpts.exporter != nil)
 		})
 	}
@@ -630,6 +676,260 @@ func TestRunCreateField_NUMBER(t *testing.T) {
 		stdout.String())
 }
 
+func TestRunCreateField_Clobber(t *testing.T) {
+	defer gock.Off()
+	gock.Observe(gock.DumpRequest)
+	// get viewer ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query ViewerOwner.*",
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"viewer": map[string]interface{}{
+					"id": "an ID",
+				},
+			},
+		})
+
+	// get project ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query ViewerProject.*",
+			"variables": map[string]interface{}{
+				"number":      1,
+				"firstItems":  0,
+				"afterItems":  nil,
+				"firstFields": 0,
+				"afterFields": nil,
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"viewer": map[string]interface{}{
+					"projectV2": map[string]interface{}{
+						"id": "an ID",
+					},
+				},
+			},
+		})
+
+	// list project fields to find the existing one
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query ViewerProject.*",
+			"variables": map[string]interface{}{
+				"number":      1,
+				"firstItems":  100,
+				"afterItems":  nil,
+				"firstFields": 100,
+				"afterFields": nil,
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"viewer": map[string]interface{}{
+					"projectV2": map[string]interface{}{
+						"fields": map[string]interface{}{
+							"nodes": []map[string]interface{}{
+								{
+									"__typename": "ProjectV2Field",
+									"id":         "existing field ID",
+									"name":       "a name",
+									"dataType":   "TEXT",
+								},
+							},
+						},
+					},
+				},
+			},
+		})
+
+	// delete the existing field
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		BodyString(`{"query":"mutation DeleteField.*","variables":{"input":{"fieldId":"existing field ID"}}}`).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"deleteProjectV2Field": map[string]interface{}{
+					"projectV2Field": map[string]interface{}{
+						"id": "existing field ID",
+					},
+				},
+			},
+		})
+
+	// create the replacement field
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		BodyString(`{"query":"mutation CreateField.*","variables":{"input":{"projectId":"an ID","dataType":"TEXT","name":"a name"}}}`).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"createProjectV2Field": map[string]interface{}{
+					"projectV2Field": map[string]interface{}{
+						"id": "Field ID",
+					},
+				},
+			},
+		})
+
+	client := queries.NewTestClient()
+
+	ios, _, stdout, _ := iostreams.Test()
+	ios.SetStdoutTTY(true)
+	config := createFieldConfig{
+		opts: createFieldOpts{
+			owner:    "@me",
+			number:   1,
+			name:     "a name",
+			dataType: "TEXT",
+			clobber:  true,
+		},
+		client: client,
+		io:     ios,
+	}
+
+	err := runCreateField(config)
+	assert.NoError(t, err)
+	assert.True(t, gock.IsDone(), "expected the existing field to be deleted before recreating")
+	assert.Equal(
+		t,
+		"Created field\n",
+		stdout.String())
+}
+
+func TestRunCreateField_FromFile(t *testing.T) {
+	defer gock.Off()
+	gock.Observe(gock.DumpRequest)
+	// get viewer ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query ViewerOwner.*",
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"viewer": map[string]interface{}{
+					"id": "an ID",
+				},
+			},
+		})
+
+	// get project ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query ViewerProject.*",
+			"variables": map[string]interface{}{
+				"number":      1,
+				"firstItems":  0,
+				"afterItems":  nil,
+				"firstFields": 0,
+				"afterFields": nil,
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"viewer": map[string]interface{}{
+					"projectV2": map[string]interface{}{
+						"id": "an ID",
+					},
+				},
+			},
+		})
+
+	// create first field
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		BodyString(`{"query":"mutation CreateField.*","variables":{"input":{"projectId":"an ID","dataType":"TEXT","name":"a text field"}}}`).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"createProjectV2Field": map[string]interface{}{
+					"projectV2Field": map[string]interface{}{
+						"id": "Field ID",
+					},
+				},
+			},
+		})
+
+	// create second field
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		BodyString(`{"query":"mutation CreateField.*","variables":{"input":{"projectId":"an ID","dataType":"SINGLE_SELECT","name":"a select field","singleSelectOptions":\[{"name":"one","color":"GRAY","description":""},{"name":"two","color":"GRAY","description":""}\]}}}`).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"createProjectV2Field": map[string]interface{}{
+					"projectV2Field": map[string]interface{}{
+						"id": "another Field ID",
+					},
+				},
+			},
+		})
+
+	specFile := filepath.Join(t.TempDir(), "fields.json")
+	assert.NoError(t, os.WriteFile(specFile, []byte(`[
+		{"name": "a text field", "dataType": "TEXT"},
+		{"name": "a select field", "dataType": "SINGLE_SELECT", "singleSelectOptions": ["one", "two"]}
+	]`), 0600))
+
+	client := queries.NewTestClient()
+
+	ios, _, stdout, _ := iostreams.Test()
+	ios.SetStdoutTTY(true)
+	config := createFieldConfig{
+		opts: createFieldOpts{
+			owner:    "@me",
+			number:   1,
+			fromFile: specFile,
+		},
+		client: client,
+		io:     ios,
+	}
+
+	err := runCreateField(config)
+	assert.NoError(t, err)
+	assert.Equal(
+		t,
+		"Created field \"a text field\"\nCreated field \"a select field\"\n",
+		stdout.String())
+}
+
+func TestRunCreateField_FromFileInvalidSpec(t *testing.T) {
+	specFile := filepath.Join(t.TempDir(), "fields.json")
+	assert.NoError(t, os.WriteFile(specFile, []byte(`[{"name": "a select field", "dataType": "SINGLE_SELECT"}]`), 0600))
+
+	ios, _, _, _ := iostreams.Test()
+	config := createFieldConfig{
+		opts: createFieldOpts{
+			owner:    "@me",
+			number:   1,
+			fromFile: specFile,
+		},
+		client: queries.NewTestClient(),
+		io:     ios,
+	}
+
+	err := runCreateField(config)
+	assert.EqualError(t, err, `field "a select field": singleSelectOptions is required for SINGLE_SELECT data type`)
+}
+
 func TestRunCreateField_JSON(t *testing.T) {
 	defer gock.Off()
 	gock.Observe(gock.DumpRequest)
@@ -2,9 +2,14 @@ package itemadd
 
 import (
 	"fmt"
+	"os"
+	"regexp"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/ghinstance"
 	"github.com/cli/cli/v2/pkg/cmd/project/shared/client"
 	"github.com/cli/cli/v2/pkg/cmd/project/shared/queries"
 	"github.com/cli/cli/v2/pkg/cmdutil"
@@ -14,12 +19,14 @@ import (
 )
 
 type addItemOpts struct {
-	owner     string
-	number    int32
-	itemURL   string
-	projectID string
-	itemID    string
-	exporter  cmdutil.Exporter
+	owner       string
+	number      int32
+	timeout     time.Duration
+	itemURLs    []string
+	ifNotExists bool
+	projectID   string
+	itemID      string
+	exporter    cmdutil.Exporter
 }
 
 type addItemConfig struct {
@@ -45,7 +52,13 @@ func NewCmdAddItem(f *cmdutil.Factory, runF func(config addItemConfig) error) *c
 		`),
 		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			client, err := client.New(f)
+			var queryClient *queries.Client
+			var err error
+			if opts.timeout > 0 {
+				queryClient, err = client.NewWithTimeout(f, opts.timeout)
+			} else {
+				queryClient, err = client.New(f)
+			}
 			if err != nil {
 				return err
 			}
@@ -59,7 +72,7 @@ func NewCmdAddItem(f *cmdutil.Factory, runF func(config addItemConfig) error) *c
 			}
 
 			config := addItemConfig{
-				client: client,
+				client: queryClient,
 				opts:   opts,
 				io:     f.IOStreams,
 			}
@@ -73,7 +86,9 @@ func NewCmdAddItem(f *cmdutil.Factory, runF func(config addItemConfig) error) *c
 	}
 
 	addItemCmd.Flags().StringVar(&opts.owner, "owner", "", "Login of the owner. Use \"@me\" for the current user.")
-	addItemCmd.Flags().StringVar(&opts.itemURL, "url", "", "URL of the issue or pull request to add to the project")
+	addItemCmd.Flags().StringArrayVar(&opts.itemURLs, "url", nil, "URL of the issue or pull request to add to the project (may be repeated)")
+	addItemCmd.Flags().BoolVar(&opts.ifNotExists, "if-not-exists", false, "Skip adding when the issue or pull request is already an item in the project")
+	addItemCmd.Flags().DurationVar(&opts.timeout, "timeout", 0, "Maximum time to wait for API requests (default no timeout)")
 	cmdutil.AddFormatFlags(addItemCmd, &opts.exporter)
 
 	_ = addItemCmd.MarkFlagRequired("url")
@@ -94,24 +109,126 @@ func runAddItem(config addItemConfig) error {
 	}
 	config.opts.projectID = project.ID
 
-	itemID, err := config.client.IssueOrPullRequestID(config.opts.itemURL)
-	if err != nil {
-		return err
+	// a single URL keeps the original behavior of surfacing the error directly
+	if len(config.opts.itemURLs) == 1 {
+		item, err := addItem(&config, config.opts.itemURLs[0])
+		if err != nil {
+			return err
+		}
+		if item == nil {
+			// the item was already present and skipped
+			return nil
+		}
+		if config.opts.exporter != nil {
+			return config.opts.exporter.Write(config.io, *item)
+		}
+		return printResults(config, *item)
 	}
-	config.opts.itemID = itemID
 
-	query, variables := addItemArgs(config)
-	err = config.client.Mutate("AddItem", query, variables)
-	if err != nil {
-		return err
+	var added []queries.ProjectItem
+	var failed int
+	for _, itemURL := range config.opts.itemURLs {
+		item, err := addItem(&config, itemURL)
+		if err != nil {
+			failed++
+			fmt.Fprintf(config.io.ErrOut, "failed to add %s: %v\n", itemURL, err)
+			continue
+		}
+		if item == nil {
+			continue
+		}
+		added = append(added, *item)
+		if config.opts.exporter == nil {
+			if err := printResults(config, *item); err != nil {
+				return err
+			}
+		}
 	}
 
 	if config.opts.exporter != nil {
-		return config.opts.exporter.Write(config.io, query.CreateProjectItem.ProjectV2Item)
+		if err := config.opts.exporter.Write(config.io, added); err != nil {
+			return err
+		}
 	}
 
-	return printResults(config, query.CreateProjectItem.ProjectV2Item)
+	if failed == len(config.opts.itemURLs) {
+		return cmdutil.SilentError
+	} else if failed > 0 {
+		return cmdutil.PartialSuccessError
+	}
+	return nil
+}
 
+// itemRefRE matches the owner/repo#123 shorthand for issues and pull requests.
+var itemRefRE = regexp.MustCompile(`^([^/\s]+)/([^/\s]+)#(\d+)$`)
+
+// canonicalItemURL translates the owner/repo#123 shorthand into a full URL
+// against the configured host. Full URLs pass through unchanged.
+func canonicalItemURL(ref string) (string, error) {
+	if strings.Contains(ref, "://") || !strings.Contains(ref, "#") {
+		return ref, nil
+	}
+	m := itemRefRE.FindStringSubmatch(ref)
+	if m == nil {
+		return "", cmdutil.FlagErrorf("invalid item reference %q, expected a URL or the \"owner/repo#number\" format", ref)
+	}
+	host := os.Getenv("GH_HOST")
+	if host == "" {
+		host = ghinstance.Default()
+	}
+	return fmt.Sprintf("https://%s/%s/%s/issues/%s", host, m[1], m[2], m[3]), nil
+}
+
+// addItem resolves a single URL and adds it to the project. It returns nil
+// without error when --if-not-exists is set and the item is already present.
+func addItem(config *addItemConfig, itemURL string) (*queries.ProjectItem, error) {
+	itemURL, err := canonicalItemURL(itemURL)
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := config.client.IssueOrPullRequest(itemURL)
+	if err != nil {
+		return nil, err
+	}
+	config.opts.itemID = content.ID
+
+	if config.opts.ifNotExists {
+		existingID, err := config.client.ProjectItemIDForContent(config.opts.projectID, content.ID)
+		if err != nil {
+			return nil, err
+		}
+		if existingID != "" {
+			if config.io.IsStdoutTTY() {
+				if _, err := fmt.Fprintf(config.io.Out, "Item already present\n"); err != nil {
+					return nil, err
+				}
+			}
+			return nil, nil
+		}
+	}
+
+	query, variables := addItemArgs(*config)
+	if err := config.client.Mutate("AddItem", query, variables); err != nil {
+		return nil, err
+	}
+
+	// the mutation response may omit content details; backfill them from the
+	// URL resolution so they survive into --format json output
+	item := &query.CreateProjectItem.ProjectV2Item
+	switch item.Content.TypeName {
+	case "Issue":
+		if item.Content.Issue.Title == "" {
+			item.Content.Issue.Title = content.Title
+			item.Content.Issue.Number = content.Number
+		}
+	case "PullRequest":
+		if item.Content.PullRequest.Title == "" {
+			item.Content.PullRequest.Title = content.Title
+			item.Content.PullRequest.Number = content.Number
+		}
+	}
+	return item, nil
 }
 
 func addItemArgs(config addItemConfig) (*addProjectItemMutation, map[string]interface{}) {
@@ -36,30 +36,30 @@ func TestNewCmdaddItem(t *testing.T) {
 			name: "url",
 			cli:  "--url github.com/cli/cli",
 			wants: addItemOpts{
-				itemURL: "github.com/cli/cli",
+				itemURLs: []string{"github.com/cli/cli"},
 			},
 		},
 		{
 			name: "number",
 			cli:  "123 --url github.com/cli/cli",
 			wants: addItemOpts{
-				number:  123,
-				itemURL: "github.com/cli/cli",
+				number:   123,
+				itemURLs: []string{"github.com/cli/cli"},
 			},
 		},
 		{
 			name: "owner",
 			cli:  "--owner monalisa --url github.com/cli/cli",
 			wants: addItemOpts{
-				owner:   "monalisa",
-				itemURL: "github.com/cli/cli",
+				owner:    "monalisa",
+				itemURLs: []string{"github.com/cli/cli"},
 			},
 		},
 		{
 			name: "json",
 			cli:  "--format json --url github.com/cli/cli",
 			wants: addItemOpts{
-				itemURL: "github.com/cli/cli",
+				itemURLs: []string{"github.com/cli/cli"},
 			},
 			wantsExporter: true,
 		},
@@ -94,12 +94,61 @@ func TestNewCmdaddItem(t *testing.T) {
 
 			assert.Equal(t, tt.wants.number, gotOpts.number)
 			assert.Equal(t, tt.wants.owner, gotOpts.owner)
-			assert.Equal(t, tt.wants.itemURL, gotOpts.itemURL)
+			assert.Equal(t, tt.wants.itemURLs, gotOpts.itemURLs)
 			assert.Equal(t, tt.wantsExporter, gotOpts.exporter != nil)
 		})
 	}
 }
 
+func TestCanonicalItemURL(t *testing.T) {
+	tests := []struct {
+		name    string
+		ref     string
+		host    string
+		want    string
+		wantErr string
+	}{
+		{
+			name: "full URL passes through",
+			ref:  "https://github.com/cli/cli/issues/42",
+			want: "https://github.com/cli/cli/issues/42",
+		},
+		{
+			name: "shorthand",
+			ref:  "cli/cli#42",
+			want: "https://github.com/cli/cli/issues/42",
+		},
+		{
+			name: "shorthand with GH_HOST",
+			ref:  "cli/cli#42",
+			host: "example.com",
+			want: "https://example.com/cli/cli/issues/42",
+		},
+		{
+			name:    "malformed shorthand",
+			ref:     "cli#42",
+			wantErr: `invalid item reference "cli#42", expected a URL or the "owner/repo#number" format`,
+		},
+		{
+			name:    "non-numeric issue number",
+			ref:     "cli/cli#abc",
+			wantErr: `invalid item reference "cli/cli#abc", expected a URL or the "owner/repo#number" format`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("GH_HOST", tt.host)
+			got, err := canonicalItemURL(tt.ref)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
 func TestRunAddItem_User(t *testing.T) {
 	defer gock.Off()
 	gock.Observe(gock.DumpRequest)
@@ -196,9 +245,9 @@ func TestRunAddItem_User(t *testing.T) {
 	ios.SetStdoutTTY(true)
 	config := addItemConfig{
 		opts: addItemOpts{
-			owner:   "monalisa",
-			number:  1,
-			itemURL: "https://github.com/cli/go-gh/issues/1",
+			owner:    "monalisa",
+			number:   1,
+			itemURLs: []string{"https://github.com/cli/go-gh/issues/1"},
 		},
 		client: client,
 		io:     ios,
@@ -212,6 +261,263 @@ func TestRunAddItem_User(t *testing.T) {
 		stdout.String())
 }
 
+func TestRunAddItem_IfNotExists_AlreadyPresent(t *testing.T) {
+	defer gock.Off()
+	gock.Observe(gock.DumpRequest)
+
+	// get user ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query UserOrgOwner.*",
+			"variables": map[string]interface{}{
+				"login": "monalisa",
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"id": "an ID",
+				},
+			},
+			"errors": []interface{}{
+				map[string]interface{}{
+					"type": "NOT_FOUND",
+					"path": []string{"organization"},
+				},
+			},
+		})
+
+	// get project ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query UserProject.*",
+			"variables": map[string]interface{}{
+				"login":       "monalisa",
+				"number":      1,
+				"firstItems":  0,
+				"afterItems":  nil,
+				"firstFields": 0,
+				"afterFields": nil,
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"projectV2": map[string]interface{}{
+						"id": "an ID",
+					},
+				},
+			},
+		})
+
+	// get item ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query GetIssueOrPullRequest.*",
+			"variables": map[string]interface{}{
+				"url": "https://github.com/cli/go-gh/issues/1",
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"resource": map[string]interface{}{
+					"id":         "item ID",
+					"__typename": "Issue",
+				},
+			},
+		})
+
+	// lookup reports the item already on the board, so no add mutation is stubbed
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query GetContentProjectItems.*",
+			"variables": map[string]interface{}{
+				"id": "item ID",
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"node": map[string]interface{}{
+					"__typename": "Issue",
+					"projectItems": map[string]interface{}{
+						"nodes": []map[string]interface{}{
+							{
+								"id": "project item ID",
+								"project": map[string]interface{}{
+									"id": "an ID",
+								},
+							},
+						},
+					},
+				},
+			},
+		})
+
+	client := queries.NewTestClient()
+
+	ios, _, stdout, _ := iostreams.Test()
+	ios.SetStdoutTTY(true)
+	config := addItemConfig{
+		opts: addItemOpts{
+			owner:       "monalisa",
+			number:      1,
+			itemURLs:    []string{"https://github.com/cli/go-gh/issues/1"},
+			ifNotExists: true,
+		},
+		client: client,
+		io:     ios,
+	}
+
+	err := runAddItem(config)
+	assert.NoError(t, err)
+	assert.Equal(
+		t,
+		"Item already present\n",
+		stdout.String())
+}
+
+func TestRunAddItem_PartialFailure(t *testing.T) {
+	defer gock.Off()
+	gock.Observe(gock.DumpRequest)
+
+	// get user ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query UserOrgOwner.*",
+			"variables": map[string]interface{}{
+				"login": "monalisa",
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"id": "an ID",
+				},
+			},
+			"errors": []interface{}{
+				map[string]interface{}{
+					"type": "NOT_FOUND",
+					"path": []string{"organization"},
+				},
+			},
+		})
+
+	// get project ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query UserProject.*",
+			"variables": map[string]interface{}{
+				"login":       "monalisa",
+				"number":      1,
+				"firstItems":  0,
+				"afterItems":  nil,
+				"firstFields": 0,
+				"afterFields": nil,
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"projectV2": map[string]interface{}{
+						"id": "an ID",
+					},
+				},
+			},
+		})
+
+	// get item ID for the first URL
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query GetIssueOrPullRequest.*",
+			"variables": map[string]interface{}{
+				"url": "https://github.com/cli/go-gh/issues/1",
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"resource": map[string]interface{}{
+					"id":         "item ID",
+					"__typename": "Issue",
+				},
+			},
+		})
+
+	// create the first item
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		BodyString(`{"query":"mutation AddItem.*","variables":{"input":{"projectId":"an ID","contentId":"item ID"}}}`).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"addProjectV2ItemById": map[string]interface{}{
+					"item": map[string]interface{}{
+						"id": "item ID",
+					},
+				},
+			},
+		})
+
+	// the second URL does not resolve
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query GetIssueOrPullRequest.*",
+			"variables": map[string]interface{}{
+				"url": "https://github.com/cli/go-gh/issues/404",
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"resource": nil,
+			},
+		})
+
+	client := queries.NewTestClient()
+
+	ios, _, stdout, stderr := iostreams.Test()
+	ios.SetStdoutTTY(true)
+	config := addItemConfig{
+		opts: addItemOpts{
+			owner:  "monalisa",
+			number: 1,
+			itemURLs: []string{
+				"https://github.com/cli/go-gh/issues/1",
+				"https://github.com/cli/go-gh/issues/404",
+			},
+		},
+		client: client,
+		io:     ios,
+	}
+
+	err := runAddItem(config)
+	assert.ErrorIs(t, err, cmdutil.PartialSuccessError)
+	assert.Equal(t, "Added item\n", stdout.String())
+	assert.Contains(t, stderr.String(), "failed to add https://github.com/cli/go-gh/issues/404")
+}
+
 func TestRunAddItem_Org(t *testing.T) {
 	defer gock.Off()
 	gock.Observe(gock.DumpRequest)
@@ -307,9 +613,9 @@ func TestRunAddItem_Org(t *testing.T) {
 	ios.SetStdoutTTY(true)
 	config := addItemConfig{
 		opts: addItemOpts{
-			owner:   "github",
-			number:  1,
-			itemURL: "https://github.com/cli/go-gh/issues/1",
+			owner:    "github",
+			number:   1,
+			itemURLs: []string{"https://github.com/cli/go-gh/issues/1"},
 		},
 		client: client,
 		io:     ios,
@@ -408,9 +714,9 @@ func TestRunAddItem_Me(t *testing.T) {
 	ios.SetStdoutTTY(true)
 	config := addItemConfig{
 		opts: addItemOpts{
-			owner:   "@me",
-			number:  1,
-			itemURL: "https://github.com/cli/go-gh/pull/1",
+			owner:    "@me",
+			number:   1,
+			itemURLs: []string{"https://github.com/cli/go-gh/pull/1"},
 		},
 		client: client,
 		io:     ios,
@@ -525,7 +831,7 @@ func TestRunAddItem_JSON(t *testing.T) {
 		opts: addItemOpts{
 			owner:    "monalisa",
 			number:   1,
-			itemURL:  "https://github.com/cli/go-gh/issues/1",
+			itemURLs: []string{"https://github.com/cli/go-gh/issues/1"},
 			exporter: cmdutil.NewJSONExporter(),
 		},
 		client: client,
@@ -539,3 +845,120 @@ func TestRunAddItem_JSON(t *testing.T) {
 		`{"id":"item ID","title":"a title","body":"","type":"Issue"}`,
 		stdout.String())
 }
+
+func TestRunAddItem_JSONResolvedTitle(t *testing.T) {
+	defer gock.Off()
+	gock.Observe(gock.DumpRequest)
+
+	// get user ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query UserOrgOwner.*",
+			"variables": map[string]interface{}{
+				"login": "monalisa",
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"id": "an ID",
+				},
+			},
+			"errors": []interface{}{
+				map[string]interface{}{
+					"type": "NOT_FOUND",
+					"path": []string{"organization"},
+				},
+			},
+		})
+
+	// get project ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query UserProject.*",
+			"variables": map[string]interface{}{
+				"login":       "monalisa",
+				"number":      1,
+				"firstItems":  0,
+				"afterItems":  nil,
+				"firstFields": 0,
+				"afterFields": nil,
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"projectV2": map[string]interface{}{
+						"id": "an ID",
+					},
+				},
+			},
+		})
+
+	// get item ID along with its title and number
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query GetIssueOrPullRequest.*",
+			"variables": map[string]interface{}{
+				"url": "https://github.com/cli/go-gh/issues/1",
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"resource": map[string]interface{}{
+					"id":         "item ID",
+					"__typename": "Issue",
+					"title":      "resolved title",
+					"number":     1,
+				},
+			},
+		})
+
+	// create item, with a response that omits the content details
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		BodyString(`{"query":"mutation AddItem.*","variables":{"input":{"projectId":"an ID","contentId":"item ID"}}}`).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"addProjectV2ItemById": map[string]interface{}{
+					"item": map[string]interface{}{
+						"id": "item ID",
+						"content": map[string]interface{}{
+							"__typename": "Issue",
+						},
+					},
+				},
+			},
+		})
+
+	client := queries.NewTestClient()
+
+	ios, _, stdout, _ := iostreams.Test()
+	config := addItemConfig{
+		opts: addItemOpts{
+			owner:    "monalisa",
+			number:   1,
+			itemURLs: []string{"https://github.com/cli/go-gh/issues/1"},
+			exporter: cmdutil.NewJSONExporter(),
+		},
+		client: client,
+		io:     ios,
+	}
+
+	err := runAddItem(config)
+	assert.NoError(t, err)
+	assert.JSONEq(
+		t,
+		`{"id":"item ID","title":"resolved title","body":"","type":"Issue"}`,
+		stdout.String())
+}
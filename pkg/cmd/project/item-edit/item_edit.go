@@ -27,6 +27,7 @@ type editItemOpts struct {
 	date                 string
 	singleSelectOptionID string
 	iterationID          string
+	iteration            string
 	clear                bool
 	// format
 	exporter cmdutil.Exporter
@@ -77,19 +78,20 @@ func NewCmdEditItem(f *cmdutil.Factory, runF func(config editItemConfig) error)
 		`),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if err := cmdutil.MutuallyExclusive(
-				"only one of `--text`, `--number`, `--date`, `--single-select-option-id` or `--iteration-id` may be used",
+				"only one of `--text`, `--number`, `--date`, `--single-select-option-id`, `--iteration-id` or `--iteration` may be used",
 				opts.text != "",
 				opts.number != 0,
 				opts.date != "",
 				opts.singleSelectOptionID != "",
 				opts.iterationID != "",
+				opts.iteration != "",
 			); err != nil {
 				return err
 			}
 
 			if err := cmdutil.MutuallyExclusive(
-				"cannot use `--text`, `--number`, `--date`, `--single-select-option-id` or `--iteration-id` in conjunction with `--clear`",
-				opts.text != "" || opts.number != 0 || opts.date != "" || opts.singleSelectOptionID != "" || opts.iterationID != "",
+				"cannot use `--text`, `--number`, `--date`, `--single-select-option-id`, `--iteration-id` or `--iteration` in conjunction with `--clear`",
+				opts.text != "" || opts.number != 0 || opts.date != "" || opts.singleSelectOptionID != "" || opts.iterationID != "" || opts.iteration != "",
 				opts.clear,
 			); err != nil {
 				return err
@@ -127,6 +129,7 @@ func NewCmdEditItem(f *cmdutil.Factory, runF func(config editItemConfig) error)
 	editItemCmd.Flags().StringVar(&opts.date, "date", "", "Date value for the field (YYYY-MM-DD)")
 	editItemCmd.Flags().StringVar(&opts.singleSelectOptionID, "single-select-option-id", "", "ID of the single select option value to set on the field")
 	editItemCmd.Flags().StringVar(&opts.iterationID, "iteration-id", "", "ID of the iteration value to set on the field")
+	editItemCmd.Flags().StringVar(&opts.iteration, "iteration", "", "Title of the iteration value to set on the field, or `@current`/`@next`")
 	editItemCmd.Flags().BoolVar(&opts.clear, "clear", false, "Remove field value")
 
 	_ = editItemCmd.MarkFlagRequired("id")
@@ -146,7 +149,7 @@ func runEditItem(config editItemConfig) error {
 	}
 
 	// update item values
-	if config.opts.text != "" || config.opts.number != 0 || config.opts.date != "" || config.opts.singleSelectOptionID != "" || config.opts.iterationID != "" {
+	if config.opts.text != "" || config.opts.number != 0 || config.opts.date != "" || config.opts.singleSelectOptionID != "" || config.opts.iterationID != "" || config.opts.iteration != "" {
 		return updateItemValues(config)
 	}
 
@@ -276,6 +279,18 @@ func updateItemValues(config editItemConfig) error {
 		parsedDate = date
 	}
 
+	if config.opts.iteration != "" {
+		iterations, err := config.client.IterationsForField(config.opts.fieldID)
+		if err != nil {
+			return err
+		}
+		id, err := resolveIterationID(iterations, config.opts.iteration, time.Now())
+		if err != nil {
+			return err
+		}
+		config.opts.iterationID = id
+	}
+
 	query, variables := buildUpdateItem(config, parsedDate)
 	err := config.client.Mutate("UpdateItemValues", query, variables)
 	if err != nil {
@@ -289,6 +304,54 @@ func updateItemValues(config editItemConfig) error {
 	return printItemResults(config, &query.Update.Item)
 }
 
+// resolveIterationID maps an --iteration selector (an iteration title,
+// @current, or @next) to an iteration ID using the field's configured
+// iterations.
+func resolveIterationID(iterations []queries.Iteration, selector string, today time.Time) (string, error) {
+	switch selector {
+	case "@current":
+		for _, it := range iterations {
+			if iterationContains(it, today) {
+				return it.ID, nil
+			}
+		}
+		return "", fmt.Errorf("no iteration contains today's date")
+	case "@next":
+		var nextID string
+		var nextStart time.Time
+		for _, it := range iterations {
+			start, err := time.Parse("2006-01-02", it.StartDate)
+			if err != nil {
+				continue
+			}
+			if start.After(today) && (nextID == "" || start.Before(nextStart)) {
+				nextID = it.ID
+				nextStart = start
+			}
+		}
+		if nextID == "" {
+			return "", fmt.Errorf("no iteration starts after today")
+		}
+		return nextID, nil
+	default:
+		for _, it := range iterations {
+			if strings.EqualFold(it.Title, selector) {
+				return it.ID, nil
+			}
+		}
+		return "", fmt.Errorf("no iteration found with title %q", selector)
+	}
+}
+
+func iterationContains(it queries.Iteration, day time.Time) bool {
+	start, err := time.Parse("2006-01-02", it.StartDate)
+	if err != nil {
+		return false
+	}
+	end := start.AddDate(0, 0, it.Duration)
+	return !day.Before(start) && day.Before(end)
+}
+
 func fieldIdAndProjectIdPresence(config editItemConfig) error {
 	if config.opts.fieldID == "" && config.opts.projectID == "" {
 		return cmdutil.FlagErrorf("field-id and project-id must be provided")
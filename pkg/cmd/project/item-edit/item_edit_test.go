@@ -2,6 +2,7 @@ package itemedit
 
 import (
 	"testing"
+	"time"
 
 	"github.com/cli/cli/v2/pkg/cmd/project/shared/queries"
 	"github.com/cli/cli/v2/pkg/cmdutil"
@@ -30,7 +31,7 @@ func TestNewCmdeditItem(t *testing.T) {
 			name:        "invalid-flags",
 			cli:         "--id 123 --text t --date 2023-01-01",
 			wantsErr:    true,
-			wantsErrMsg: "only one of `--text`, `--number`, `--date`, `--single-select-option-id` or `--iteration-id` may be used",
+			wantsErrMsg: "only one of `--text`, `--number`, `--date`, `--single-select-option-id`, `--iteration-id` or `--iteration` may be used",
 		},
 		{
 			name: "item-id",
@@ -575,3 +576,121 @@ func TestRunItemEdit_JSON(t *testing.T) {
 		`{"id":"DI_item_id","title":"a title","body":"a new body","type":"DraftIssue"}`,
 		stdout.String())
 }
+
+func TestResolveIterationID(t *testing.T) {
+	today := time.Date(2024, 6, 10, 0, 0, 0, 0, time.UTC)
+	iterations := []queries.Iteration{
+		{ID: "iter-next", Title: "Sprint 3", StartDate: "2024-06-17", Duration: 14},
+		{ID: "iter-current", Title: "Sprint 2", StartDate: "2024-06-03", Duration: 14},
+		{ID: "iter-done", Title: "Sprint 1", StartDate: "2024-05-20", Duration: 14},
+	}
+
+	tests := []struct {
+		name     string
+		selector string
+		want     string
+		wantErr  string
+	}{
+		{
+			name:     "@current resolves the iteration containing today",
+			selector: "@current",
+			want:     "iter-current",
+		},
+		{
+			name:     "@next resolves the earliest future iteration",
+			selector: "@next",
+			want:     "iter-next",
+		},
+		{
+			name:     "title matches case-insensitively",
+			selector: "sprint 1",
+			want:     "iter-done",
+		},
+		{
+			name:     "unknown title errors",
+			selector: "Sprint 9",
+			wantErr:  `no iteration found with title "Sprint 9"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			id, err := resolveIterationID(iterations, tt.selector, today)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, id)
+		})
+	}
+}
+
+func TestRunItemEdit_IterationByTitle(t *testing.T) {
+	defer gock.Off()
+	// gock.Observe(gock.DumpRequest)
+
+	// resolve iteration field configuration
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		BodyString(`{"query":"query IterationFieldConfiguration.*","variables":{"id":"field_id"}}`).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"node": map[string]interface{}{
+					"configuration": map[string]interface{}{
+						"iterations": []map[string]interface{}{
+							{"id": "iteration_id", "title": "Sprint 2", "startDate": "2024-06-03", "duration": 14},
+						},
+						"completedIterations": []map[string]interface{}{},
+					},
+				},
+			},
+		})
+
+	// edit item
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		BodyString(`{"query":"mutation UpdateItemValues.*","variables":{"input":{"projectId":"project_id","itemId":"item_id","fieldId":"field_id","value":{"iterationId":"iteration_id"}}}}`).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"updateProjectV2ItemFieldValue": map[string]interface{}{
+					"projectV2Item": map[string]interface{}{
+						"ID": "item_id",
+						"content": map[string]interface{}{
+							"__typename": "Issue",
+							"body":       "body",
+							"title":      "title",
+							"number":     1,
+							"repository": map[string]interface{}{
+								"nameWithOwner": "my-repo",
+							},
+						},
+					},
+				},
+			},
+		})
+
+	client := queries.NewTestClient()
+
+	ios, _, stdout, _ := iostreams.Test()
+	ios.SetStdoutTTY(true)
+
+	config := editItemConfig{
+		io: ios,
+		opts: editItemOpts{
+			iteration: "Sprint 2",
+			itemID:    "item_id",
+			projectID: "project_id",
+			fieldID:   "field_id",
+		},
+		client: client,
+	}
+
+	err := runEditItem(config)
+	assert.NoError(t, err)
+	assert.Equal(
+		t,
+		"Edited item \"title\"\n",
+		stdout.String())
+}
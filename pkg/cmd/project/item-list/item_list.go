@@ -2,7 +2,9 @@ package itemlist
 
 import (
 	"fmt"
+	"slices"
 	"strconv"
+	"strings"
 
 	"github.com/MakeNowJust/heredoc"
 	"github.com/cli/cli/v2/internal/tableprinter"
@@ -17,9 +19,16 @@ type listOpts struct {
 	limit    int
 	owner    string
 	number   int32
+	columns  []string
 	exporter cmdutil.Exporter
 }
 
+// validColumns is the set of columns selectable with --columns.
+var validColumns = []string{"type", "title", "number", "repository", "url", "id"}
+
+// defaultColumns is the column selection used when --columns is not given.
+var defaultColumns = []string{"type", "title", "number", "repository", "id"}
+
 type listConfig struct {
 	io     *iostreams.IOStreams
 	client *queries.Client
@@ -50,6 +59,13 @@ func NewCmdList(f *cmdutil.Factory, runF func(config listConfig) error) *cobra.C
 				opts.number = int32(num)
 			}
 
+			for i, column := range opts.columns {
+				opts.columns[i] = strings.ToLower(column)
+				if !slices.Contains(validColumns, opts.columns[i]) {
+					return cmdutil.FlagErrorf("invalid column %q, valid columns are: %s", column, strings.Join(validColumns, ", "))
+				}
+			}
+
 			config := listConfig{
 				io:     f.IOStreams,
 				client: client,
@@ -67,6 +83,7 @@ func NewCmdList(f *cmdutil.Factory, runF func(config listConfig) error) *cobra.C
 	listCmd.Flags().StringVar(&opts.owner, "owner", "", "Login of the owner. Use \"@me\" for the current user.")
 	cmdutil.AddFormatFlags(listCmd, &opts.exporter)
 	listCmd.Flags().IntVarP(&opts.limit, "limit", "L", queries.LimitDefault, "Maximum number of items to fetch")
+	listCmd.Flags().StringSliceVar(&opts.columns, "columns", nil, "Comma-separated list of columns to display")
 
 	return listCmd
 }
@@ -104,20 +121,40 @@ func printResults(config listConfig, items []queries.ProjectItem, login string)
 		return cmdutil.NewNoResultsError(fmt.Sprintf("Project %d for owner %s has no items", config.opts.number, login))
 	}
 
-	tp := tableprinter.New(config.io, tableprinter.WithHeader("Type", "Title", "Number", "Repository", "ID"))
+	columns := config.opts.columns
+	if len(columns) == 0 {
+		columns = defaultColumns
+	}
+
+	tp := tableprinter.New(config.io, tableprinter.WithHeader(columns...))
 
 	for _, i := range items {
+		for _, column := range columns {
+			addColumnField(tp, column, i)
+		}
+		tp.EndRow()
+	}
+
+	return tp.Render()
+}
+
+func addColumnField(tp *tableprinter.TablePrinter, column string, i queries.ProjectItem) {
+	switch column {
+	case "type":
 		tp.AddField(i.Type())
+	case "title":
 		tp.AddField(i.Title())
+	case "number":
 		if i.Number() == 0 {
 			tp.AddField("")
 		} else {
 			tp.AddField(strconv.Itoa(i.Number()))
 		}
+	case "repository":
 		tp.AddField(i.Repo())
+	case "url":
+		tp.AddField(i.URL())
+	case "id":
 		tp.AddField(i.ID(), tableprinter.WithTruncate(nil))
-		tp.EndRow()
 	}
-
-	return tp.Render()
 }
@@ -2,11 +2,21 @@ package client
 
 import (
 	"os"
+	"sync"
+	"time"
 
 	"github.com/cli/cli/v2/pkg/cmd/project/shared/queries"
 	"github.com/cli/cli/v2/pkg/cmdutil"
 )
 
+var (
+	clientsMu sync.Mutex
+	// clients memoizes the GraphQL client per factory so that composite
+	// commands running several project operations in one invocation share a
+	// single client and its configured transport.
+	clients = map[*cmdutil.Factory]*queries.Client{}
+)
+
 func New(f *cmdutil.Factory) (*queries.Client, error) {
 	if f.HttpClient == nil {
 		// This is for compatibility with tests that exercise Cobra command functionality.
@@ -14,9 +24,35 @@ func New(f *cmdutil.Factory) (*queries.Client, error) {
 		return nil, nil
 	}
 
+	clientsMu.Lock()
+	defer clientsMu.Unlock()
+	if client, ok := clients[f]; ok {
+		return client, nil
+	}
+
+	httpClient, err := f.HttpClient()
+	if err != nil {
+		return nil, err
+	}
+	client := queries.NewClient(httpClient, os.Getenv("GH_HOST"), f.IOStreams)
+	clients[f] = client
+	return client, nil
+}
+
+// NewWithTimeout is New with a per-request deadline applied to every API call.
+// Clients with custom timeouts are not shared across operations.
+func NewWithTimeout(f *cmdutil.Factory, timeout time.Duration) (*queries.Client, error) {
+	if f.HttpClient == nil {
+		// This is for compatibility with tests that exercise Cobra command functionality.
+		// These tests do not define a `HttpClient` nor do they need to.
+		return nil, nil
+	}
+
 	httpClient, err := f.HttpClient()
 	if err != nil {
 		return nil, err
 	}
-	return queries.NewClient(httpClient, os.Getenv("GH_HOST"), f.IOStreams), nil
+	timeoutClient := *httpClient
+	timeoutClient.Timeout = timeout
+	return queries.NewClientWithTimeout(&timeoutClient, os.Getenv("GH_HOST"), f.IOStreams, timeout), nil
 }
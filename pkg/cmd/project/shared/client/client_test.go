@@ -0,0 +1,36 @@
+package client
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewReusesClientPerFactory(t *testing.T) {
+	ios, _, _, _ := iostreams.Test()
+	f := &cmdutil.Factory{
+		IOStreams: ios,
+		HttpClient: func() (*http.Client, error) {
+			return http.DefaultClient, nil
+		},
+	}
+
+	first, err := New(f)
+	assert.NoError(t, err)
+	second, err := New(f)
+	assert.NoError(t, err)
+	assert.Same(t, first, second)
+
+	other := &cmdutil.Factory{
+		IOStreams: ios,
+		HttpClient: func() (*http.Client, error) {
+			return http.DefaultClient, nil
+		},
+	}
+	third, err := New(other)
+	assert.NoError(t, err)
+	assert.NotSame(t, first, third)
+}
@@ -1,8 +1,11 @@
 package queries
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
+	"io"
+	"net"
 	"net/http"
 	"net/url"
 	"regexp"
@@ -14,10 +17,22 @@ import (
 	"github.com/cli/cli/v2/internal/prompter"
 	"github.com/cli/cli/v2/pkg/iostreams"
 	"github.com/cli/cli/v2/pkg/set"
+	"github.com/cli/cli/v2/utils"
 	"github.com/shurcooL/githubv4"
 )
 
 func NewClient(httpClient *http.Client, hostname string, ios *iostreams.IOStreams) *Client {
+	var debugOut io.Writer
+	if debugEnabled, debugValue := utils.IsDebugEnabled(); debugEnabled && debugValue == "api" {
+		debugOut = ios.ErrOut
+		verboseClient := *httpClient
+		transport := verboseClient.Transport
+		if transport == nil {
+			transport = http.DefaultTransport
+		}
+		verboseClient.Transport = &verboseTransport{rt: transport, out: debugOut}
+		httpClient = &verboseClient
+	}
 	apiClient := &hostScopedClient{
 		hostname: hostname,
 		Client:   api.NewClientFromHTTP(httpClient),
@@ -26,9 +41,18 @@ func NewClient(httpClient *http.Client, hostname string, ios *iostreams.IOStream
 		apiClient: apiClient,
 		spinner:   ios.IsStdoutTTY() && ios.IsStderrTTY(),
 		prompter:  prompter.New("", ios.In, ios.Out, ios.ErrOut),
+		debugOut:  debugOut,
 	}
 }
 
+// NewClientWithTimeout returns a Client that cancels requests outliving the
+// given timeout and reports them with a clear error message.
+func NewClientWithTimeout(httpClient *http.Client, hostname string, ios *iostreams.IOStreams, timeout time.Duration) *Client {
+	c := NewClient(httpClient, hostname, ios)
+	c.timeout = timeout
+	return c
+}
+
 // TestClientOpt is a test option for the test client.
 type TestClientOpt func(*Client)
 
@@ -78,10 +102,62 @@ type graphqlClient interface {
 	Mutate(queryName string, query interface{}, variables map[string]interface{}) error
 }
 
+// verboseTransport dumps each request and response to debugOut when
+// GH_DEBUG=api is set, giving a runtime view of the exact GraphQL queries,
+// variables, and raw responses. The Authorization header is redacted.
+type verboseTransport struct {
+	rt  http.RoundTripper
+	out io.Writer
+}
+
+func (t *verboseTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	fmt.Fprintf(t.out, "* Request to %s %s\n", req.Method, req.URL)
+	for name, values := range req.Header {
+		value := strings.Join(values, ", ")
+		if strings.EqualFold(name, "Authorization") {
+			value = "[REDACTED]"
+		}
+		fmt.Fprintf(t.out, "> %s: %s\n", name, value)
+	}
+	if req.Body != nil {
+		body, err := io.ReadAll(req.Body)
+		if err != nil {
+			return nil, err
+		}
+		req.Body = io.NopCloser(bytes.NewReader(body))
+		fmt.Fprintf(t.out, "%s\n", body)
+	}
+
+	resp, err := t.rt.RoundTrip(req)
+	if err != nil {
+		return resp, err
+	}
+
+	fmt.Fprintf(t.out, "* Response %s\n", resp.Status)
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	_ = resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	fmt.Fprintf(t.out, "%s\n", body)
+	return resp, nil
+}
+
 type Client struct {
 	apiClient graphqlClient
 	spinner   bool
 	prompter  iprompter
+	// debugOut receives a line per GraphQL operation with its elapsed time
+	// when GH_DEBUG=api is set; it is nil otherwise.
+	debugOut io.Writer
+	// timeout is the per-request deadline configured on the HTTP client,
+	// used only to phrase timeout errors; zero means no timeout.
+	timeout time.Duration
+	// fieldCache memoizes project field lists so that resolving multiple
+	// field names within a single invocation issues at most one query
+	// per project.
+	fieldCache map[string][]ProjectField
 }
 
 const (
@@ -98,17 +174,28 @@ func (c *Client) doQuery(name string, query interface{}, variables map[string]in
 		sp = spinner.New(dotStyle, 120*time.Millisecond, spinner.WithColor("fgCyan"))
 		sp.Start()
 	}
+	start := time.Now()
 	err := c.apiClient.Query(name, query, variables)
 	if sp != nil {
 		sp.Stop()
 	}
-	return handleError(err)
+	c.logOperation(name, start)
+	return c.handleError(err)
 }
 
 // TODO: un-export this since it couples the caller heavily to api.GraphQLClient
 func (c *Client) Mutate(operationName string, query interface{}, variables map[string]interface{}) error {
+	start := time.Now()
 	err := c.apiClient.Mutate(operationName, query, variables)
-	return handleError(err)
+	c.logOperation(operationName, start)
+	return c.handleError(err)
+}
+
+func (c *Client) logOperation(name string, start time.Time) {
+	if c.debugOut == nil {
+		return
+	}
+	fmt.Fprintf(c.debugOut, "GraphQL %s (%s)\n", name, time.Since(start).Round(time.Millisecond))
 }
 
 // PageInfo is a PageInfo GraphQL object https://docs.github.com/en/graphql/reference/objects#pageinfo.
@@ -877,6 +964,92 @@ func (c *Client) ProjectFields(o *Owner, number int32, limit int) (*Project, err
 	return project, nil
 }
 
+// cachedProjectFields returns the fields of a project, querying the API at
+// most once per project for the lifetime of the client.
+func (c *Client) cachedProjectFields(o *Owner, number int32) ([]ProjectField, error) {
+	key := fmt.Sprintf("%s/%s/%d", o.Type, o.Login, number)
+	if fields, ok := c.fieldCache[key]; ok {
+		return fields, nil
+	}
+	project, err := c.ProjectFields(o, number, LimitMax)
+	if err != nil {
+		return nil, err
+	}
+	if c.fieldCache == nil {
+		c.fieldCache = make(map[string][]ProjectField)
+	}
+	c.fieldCache[key] = project.Fields.Nodes
+	return project.Fields.Nodes, nil
+}
+
+// ErrFieldNotFound distinguishes a missing field from other lookup failures
+// so callers can treat absence as a non-error, e.g. `field-create --clobber`.
+var ErrFieldNotFound = errors.New("not found in project")
+
+// ProjectFieldByName resolves a project field by its human-readable name.
+// The name comparison is case-insensitive.
+func (c *Client) ProjectFieldByName(o *Owner, number int32, name string) (ProjectField, error) {
+	fields, err := c.cachedProjectFields(o, number)
+	if err != nil {
+		return ProjectField{}, err
+	}
+	for _, f := range fields {
+		if strings.EqualFold(f.Name(), name) {
+			return f, nil
+		}
+	}
+	return ProjectField{}, fmt.Errorf("field %q %w", name, ErrFieldNotFound)
+}
+
+// Iteration is a single iteration configured on a ProjectV2IterationField.
+type Iteration struct {
+	ID        string
+	Title     string
+	StartDate string
+	Duration  int
+}
+
+// iterationFieldConfiguration queries the iterations configured on an
+// iteration field by its node ID.
+type iterationFieldConfiguration struct {
+	Node struct {
+		IterationField struct {
+			Configuration struct {
+				Iterations          []Iteration
+				CompletedIterations []Iteration
+			}
+		} `graphql:"... on ProjectV2IterationField"`
+	} `graphql:"node(id: $id)"`
+}
+
+// IterationsForField returns the iterations configured on an iteration field,
+// upcoming ones first followed by completed ones.
+func (c *Client) IterationsForField(fieldID string) ([]Iteration, error) {
+	variables := map[string]interface{}{
+		"id": githubv4.ID(fieldID),
+	}
+	var query iterationFieldConfiguration
+	if err := c.doQuery("IterationFieldConfiguration", &query, variables); err != nil {
+		return nil, err
+	}
+	cfg := query.Node.IterationField.Configuration
+	return append(cfg.Iterations, cfg.CompletedIterations...), nil
+}
+
+// OptionID resolves a single-select option by its label. The label
+// comparison is case-insensitive.
+func (p ProjectField) OptionID(name string) (string, error) {
+	if p.TypeName != "ProjectV2SingleSelectField" {
+		return "", fmt.Errorf("field %q is not a single select field", p.Name())
+	}
+	for _, o := range p.SingleSelectField.Options {
+		if strings.EqualFold(o.Name, name) {
+			return o.ID, nil
+		}
+	}
+	return "", fmt.Errorf("option %q not found on field %q", name, p.Name())
+}
+
 // viewerLogin is used to query the Login of the viewer.
 type viewerLogin struct {
 	Viewer struct {
@@ -1028,24 +1201,38 @@ func (c *Client) OwnerIDAndType(login string) (string, OwnerType, error) {
 	return "", "", errors.New("unknown owner type")
 }
 
-// issueOrPullRequest is used to query the global id of an issue or pull request by its URL.
+// issueOrPullRequest is used to query an issue or pull request by its URL.
 type issueOrPullRequest struct {
 	Resource struct {
 		Typename string `graphql:"__typename"`
 		Issue    struct {
-			ID string
+			ID     string
+			Title  string
+			Number int
 		} `graphql:"... on Issue"`
 		PullRequest struct {
-			ID string
+			ID     string
+			Title  string
+			Number int
 		} `graphql:"... on PullRequest"`
 	} `graphql:"resource(url: $url)"`
 }
 
-// IssueOrPullRequestID returns the ID of the issue or pull request from a URL.
-func (c *Client) IssueOrPullRequestID(rawURL string) (string, error) {
+// IssueOrPullRequestContent holds the resolved details of an issue or pull
+// request looked up by URL.
+type IssueOrPullRequestContent struct {
+	ID       string
+	Typename string
+	Title    string
+	Number   int
+}
+
+// IssueOrPullRequest resolves an issue or pull request from a URL, capturing
+// its title and number alongside the global ID.
+func (c *Client) IssueOrPullRequest(rawURL string) (IssueOrPullRequestContent, error) {
 	uri, err := url.Parse(rawURL)
 	if err != nil {
-		return "", err
+		return IssueOrPullRequestContent{}, err
 	}
 	variables := map[string]interface{}{
 		"url": githubv4.URI{URL: uri},
@@ -1053,14 +1240,83 @@ func (c *Client) IssueOrPullRequestID(rawURL string) (string, error) {
 	var query issueOrPullRequest
 	err = c.doQuery("GetIssueOrPullRequest", &query, variables)
 	if err != nil {
-		return "", err
+		return IssueOrPullRequestContent{}, err
 	}
 	if query.Resource.Typename == "Issue" {
-		return query.Resource.Issue.ID, nil
+		return IssueOrPullRequestContent{
+			ID:       query.Resource.Issue.ID,
+			Typename: query.Resource.Typename,
+			Title:    query.Resource.Issue.Title,
+			Number:   query.Resource.Issue.Number,
+		}, nil
 	} else if query.Resource.Typename == "PullRequest" {
-		return query.Resource.PullRequest.ID, nil
+		return IssueOrPullRequestContent{
+			ID:       query.Resource.PullRequest.ID,
+			Typename: query.Resource.Typename,
+			Title:    query.Resource.PullRequest.Title,
+			Number:   query.Resource.PullRequest.Number,
+		}, nil
+	}
+	return IssueOrPullRequestContent{}, errors.New("resource not found, please check the URL")
+}
+
+// IssueOrPullRequestID returns the ID of the issue or pull request from a URL.
+func (c *Client) IssueOrPullRequestID(rawURL string) (string, error) {
+	content, err := c.IssueOrPullRequest(rawURL)
+	if err != nil {
+		return "", err
+	}
+	return content.ID, nil
+}
+
+// contentProjectItems is used to query the project items an issue or pull request already belongs to.
+type contentProjectItems struct {
+	Node struct {
+		Typename string `graphql:"__typename"`
+		Issue    struct {
+			ProjectItems struct {
+				Nodes []struct {
+					ID      string
+					Project struct {
+						ID string
+					}
+				}
+			} `graphql:"projectItems(first: 100)"`
+		} `graphql:"... on Issue"`
+		PullRequest struct {
+			ProjectItems struct {
+				Nodes []struct {
+					ID      string
+					Project struct {
+						ID string
+					}
+				}
+			} `graphql:"projectItems(first: 100)"`
+		} `graphql:"... on PullRequest"`
+	} `graphql:"node(id: $id)"`
+}
+
+// ProjectItemIDForContent returns the ID of the project item representing the
+// given issue or pull request within a project, or an empty string when the
+// content has not been added to the project.
+func (c *Client) ProjectItemIDForContent(projectID, contentID string) (string, error) {
+	variables := map[string]interface{}{
+		"id": githubv4.ID(contentID),
+	}
+	var query contentProjectItems
+	if err := c.doQuery("GetContentProjectItems", &query, variables); err != nil {
+		return "", err
+	}
+	nodes := query.Node.Issue.ProjectItems.Nodes
+	if query.Node.Typename == "PullRequest" {
+		nodes = query.Node.PullRequest.ProjectItems.Nodes
+	}
+	for _, n := range nodes {
+		if n.Project.ID == projectID {
+			return n.ID, nil
+		}
 	}
-	return "", errors.New("resource not found, please check the URL")
+	return "", nil
 }
 
 // userProjects queries the $first projects of a user.
@@ -1288,6 +1544,16 @@ func (c *Client) NewProject(canPrompt bool, o *Owner, number int32, fields bool)
 	return &projects.Nodes[answerIndex], nil
 }
 
+// ProjectID resolves the node ID of the project with the given number owned by o.
+// It supports viewer, user, and organization owners.
+func (c *Client) ProjectID(o *Owner, number int32) (string, error) {
+	project, err := c.NewProject(false, o, number, false)
+	if err != nil {
+		return "", err
+	}
+	return project.ID, nil
+}
+
 // Projects returns all the projects for an Owner. If the OwnerType is VIEWER, no login is required.
 // If limit is 0, the default limit is used.
 func (c *Client) Projects(login string, t OwnerType, limit int, fields bool) (Projects, error) {
@@ -1445,6 +1711,51 @@ func (c *Client) UnlinkProjectFromTeam(projectID string, teamID string) error {
 	return c.Mutate("UnlinkProjectV2FromTeam", &mutation, variables)
 }
 
+func (c *Client) handleError(err error) error {
+	if err != nil && c.timeout > 0 {
+		var netErr net.Error
+		if errors.As(err, &netErr) && netErr.Timeout() {
+			return fmt.Errorf("request timed out after %s", c.timeout)
+		}
+	}
+	return handleError(err)
+}
+
+// Sentinel errors for common project GraphQL failures. Errors returned from
+// the shared resolution code match these with errors.Is, so callers can
+// distinguish failure modes without parsing message strings.
+var (
+	ErrProjectNotFound   = errors.New("project not found")
+	ErrInsufficientScope = errors.New("insufficient token scopes")
+)
+
+// InsufficientScopeError reports which token scopes the server demanded. It
+// matches ErrInsufficientScope with errors.Is.
+type InsufficientScopeError struct {
+	MissingScopes []string
+}
+
+func (e *InsufficientScopeError) Error() string {
+	// TODO: this duplicates parts of generateScopesSuggestion
+	return fmt.Sprintf(
+		"error: your authentication token is missing required scopes %v\n"+
+			"To request it, run:  gh auth refresh -s %s",
+		e.MissingScopes,
+		strings.Join(e.MissingScopes, ","))
+}
+
+func (e *InsufficientScopeError) Is(target error) bool { return target == ErrInsufficientScope }
+
+// ProjectNotFoundError preserves the server's message while matching
+// ErrProjectNotFound with errors.Is.
+type ProjectNotFoundError struct {
+	message string
+}
+
+func (e *ProjectNotFoundError) Error() string { return e.message }
+
+func (e *ProjectNotFoundError) Is(target error) bool { return target == ErrProjectNotFound }
+
 func handleError(err error) error {
 	var gerr api.GraphQLError
 	if errors.As(err, &gerr) {
@@ -1456,13 +1767,12 @@ func handleError(err error) error {
 			missing.AddValues(requiredScopesFromServerMessage(e.Message))
 		}
 		if missing.Len() > 0 {
-			s := missing.ToSlice()
-			// TODO: this duplicates parts of generateScopesSuggestion
-			return fmt.Errorf(
-				"error: your authentication token is missing required scopes %v\n"+
-					"To request it, run:  gh auth refresh -s %s",
-				s,
-				strings.Join(s, ","))
+			return &InsufficientScopeError{MissingScopes: missing.ToSlice()}
+		}
+		for _, e := range gerr.Errors {
+			if e.Type == "NOT_FOUND" && strings.Contains(e.Message, "ProjectV2") {
+				return &ProjectNotFoundError{message: e.Message}
+			}
 		}
 	}
 	return err
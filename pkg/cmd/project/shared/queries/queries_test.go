@@ -1,9 +1,15 @@
 package queries
 
 import (
+	"bytes"
+	"io"
+	"net/http"
 	"reflect"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/cli/cli/v2/pkg/iostreams"
 	"github.com/stretchr/testify/assert"
 	"gopkg.in/h2non/gock.v1"
 )
@@ -321,6 +327,263 @@ func TestProjectFields_NoLimit(t *testing.T) {
 	assert.Len(t, project.Fields.Nodes, 3)
 }
 
+func TestProjectFieldByName_SingleSelectOption(t *testing.T) {
+	defer gock.Off()
+	gock.Observe(gock.DumpRequest)
+
+	// list project fields, queried only once despite two resolutions
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		JSON(map[string]interface{}{
+			"query": "query UserProject.*",
+			"variables": map[string]interface{}{
+				"login":       "monalisa",
+				"number":      1,
+				"firstItems":  LimitMax,
+				"afterItems":  nil,
+				"firstFields": LimitMax,
+				"afterFields": nil,
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"projectV2": map[string]interface{}{
+						"fields": map[string]interface{}{
+							"nodes": []map[string]interface{}{
+								{
+									"__typename": "ProjectV2SingleSelectField",
+									"id":         "status field ID",
+									"name":       "Status",
+									"options": []map[string]interface{}{
+										{
+											"id":   "todo option ID",
+											"name": "Todo",
+										},
+										{
+											"id":   "done option ID",
+											"name": "Done",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		})
+
+	client := NewTestClient()
+	owner := &Owner{
+		Type:  "USER",
+		Login: "monalisa",
+		ID:    "user ID",
+	}
+
+	field, err := client.ProjectFieldByName(owner, 1, "status")
+	assert.NoError(t, err)
+	assert.Equal(t, "status field ID", field.ID())
+
+	optionID, err := field.OptionID("done")
+	assert.NoError(t, err)
+	assert.Equal(t, "done option ID", optionID)
+
+	_, err = field.OptionID("bogus")
+	assert.EqualError(t, err, `option "bogus" not found on field "Status"`)
+
+	// second resolution hits the cache rather than the API
+	_, err = client.ProjectFieldByName(owner, 1, "bogus")
+	assert.EqualError(t, err, `field "bogus" not found in project`)
+}
+
+func TestDoQuery_DebugLogging(t *testing.T) {
+	t.Setenv("GH_DEBUG", "api")
+
+	defer gock.Off()
+	gock.Observe(gock.DumpRequest)
+
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"projectV2": map[string]interface{}{
+						"fields": map[string]interface{}{
+							"nodes": []map[string]interface{}{},
+						},
+					},
+				},
+			},
+		})
+
+	ios, _, _, stderr := iostreams.Test()
+	client := NewClient(http.DefaultClient, "github.com", ios)
+
+	owner := &Owner{
+		Type:  "USER",
+		Login: "monalisa",
+		ID:    "user ID",
+	}
+	_, err := client.ProjectFields(owner, 1, LimitMax)
+	assert.NoError(t, err)
+	assert.Contains(t, stderr.String(), "GraphQL UserProjectWithFields")
+}
+
+func TestDoQuery_VerboseDump(t *testing.T) {
+	t.Setenv("GH_DEBUG", "api")
+
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"projectV2": map[string]interface{}{
+						"fields": map[string]interface{}{
+							"nodes": []map[string]interface{}{},
+						},
+					},
+				},
+			},
+		})
+
+	ios, _, _, stderr := iostreams.Test()
+	client := NewClient(http.DefaultClient, "github.com", ios)
+
+	owner := &Owner{
+		Type:  "USER",
+		Login: "monalisa",
+		ID:    "user ID",
+	}
+	_, err := client.ProjectFields(owner, 1, LimitMax)
+	assert.NoError(t, err)
+	// the outgoing query, its variables, and the raw response all appear
+	assert.Contains(t, stderr.String(), `"query":"query UserProjectWithFields`)
+	assert.Contains(t, stderr.String(), `"login":"monalisa"`)
+	assert.Contains(t, stderr.String(), "* Response 200 OK")
+	assert.Contains(t, stderr.String(), `"nodes":[]`)
+}
+
+func TestDoQuery_NoVerboseDumpByDefault(t *testing.T) {
+	t.Setenv("GH_DEBUG", "")
+
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"projectV2": map[string]interface{}{
+						"fields": map[string]interface{}{
+							"nodes": []map[string]interface{}{},
+						},
+					},
+				},
+			},
+		})
+
+	ios, _, _, stderr := iostreams.Test()
+	client := NewClient(http.DefaultClient, "github.com", ios)
+
+	owner := &Owner{
+		Type:  "USER",
+		Login: "monalisa",
+		ID:    "user ID",
+	}
+	_, err := client.ProjectFields(owner, 1, LimitMax)
+	assert.NoError(t, err)
+	assert.Empty(t, stderr.String())
+}
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestNewClient_CustomTransport(t *testing.T) {
+	// e.g. corporate proxies and custom TLS roots configure their own
+	// transport; every query must round-trip through it
+	calls := 0
+	httpClient := &http.Client{
+		Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+			calls++
+			return &http.Response{
+				Status:     "200 OK",
+				StatusCode: 200,
+				Header:     http.Header{"Content-Type": []string{"application/json"}},
+				Body:       io.NopCloser(strings.NewReader(`{"data":{"user":{"projectV2":{"fields":{"nodes":[]}}}}}`)),
+				Request:    req,
+			}, nil
+		}),
+	}
+
+	ios, _, _, _ := iostreams.Test()
+	client := NewClient(httpClient, "github.com", ios)
+
+	owner := &Owner{
+		Type:  "USER",
+		Login: "monalisa",
+		ID:    "user ID",
+	}
+	_, err := client.ProjectFields(owner, 1, LimitMax)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, calls)
+}
+
+func TestVerboseTransport_RedactsAuthorization(t *testing.T) {
+	out := &bytes.Buffer{}
+	transport := &verboseTransport{
+		rt: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+			return &http.Response{
+				Status:     "200 OK",
+				StatusCode: 200,
+				Body:       io.NopCloser(strings.NewReader(`{"data":{}}`)),
+			}, nil
+		}),
+		out: out,
+	}
+
+	req, err := http.NewRequest(http.MethodPost, "https://api.github.com/graphql", strings.NewReader(`{"query":"query {viewer{id}}"}`))
+	assert.NoError(t, err)
+	req.Header.Set("Authorization", "token super-secret")
+
+	resp, err := transport.RoundTrip(req)
+	assert.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Contains(t, out.String(), "Authorization: [REDACTED]")
+	assert.NotContains(t, out.String(), "super-secret")
+	assert.Contains(t, out.String(), `{"query":"query {viewer{id}}"}`)
+	assert.Contains(t, out.String(), `{"data":{}}`)
+
+	// the body remains readable downstream
+	body, err := io.ReadAll(resp.Body)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"data":{}}`, string(body))
+}
+
+type fakeTimeoutError struct{}
+
+func (fakeTimeoutError) Error() string   { return "context deadline exceeded" }
+func (fakeTimeoutError) Timeout() bool   { return true }
+func (fakeTimeoutError) Temporary() bool { return false }
+
+func TestClientTimeout(t *testing.T) {
+	ios, _, _, _ := iostreams.Test()
+	timeout := 100 * time.Millisecond
+	client := NewClientWithTimeout(&http.Client{Timeout: timeout}, "github.com", ios, timeout)
+
+	err := client.handleError(fakeTimeoutError{})
+	assert.EqualError(t, err, "request timed out after 100ms")
+}
+
 func Test_requiredScopesFromServerMessage(t *testing.T) {
 	tests := []struct {
 		name string
@@ -435,3 +698,152 @@ func TestCamelCase(t *testing.T) {
 	assert.Equal(t, "c", camelCase("C"))
 	assert.Equal(t, "", camelCase(""))
 }
+
+func TestProjectID_User(t *testing.T) {
+	defer gock.Off()
+	gock.Observe(gock.DumpRequest)
+
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		JSON(map[string]interface{}{
+			"query": "query UserProject.*",
+			"variables": map[string]interface{}{
+				"login":       "monalisa",
+				"number":      1,
+				"firstItems":  0,
+				"afterItems":  nil,
+				"firstFields": 0,
+				"afterFields": nil,
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"projectV2": map[string]interface{}{
+						"id": "user-project-id",
+					},
+				},
+			},
+		})
+
+	client := NewTestClient()
+
+	id, err := client.ProjectID(&Owner{Login: "monalisa", Type: UserOwner}, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, "user-project-id", id)
+}
+
+func TestProjectID_Org(t *testing.T) {
+	defer gock.Off()
+	gock.Observe(gock.DumpRequest)
+
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		JSON(map[string]interface{}{
+			"query": "query OrgProject.*",
+			"variables": map[string]interface{}{
+				"login":       "github",
+				"number":      1,
+				"firstItems":  0,
+				"afterItems":  nil,
+				"firstFields": 0,
+				"afterFields": nil,
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"organization": map[string]interface{}{
+					"projectV2": map[string]interface{}{
+						"id": "org-project-id",
+					},
+				},
+			},
+		})
+
+	client := NewTestClient()
+
+	id, err := client.ProjectID(&Owner{Login: "github", Type: OrgOwner}, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, "org-project-id", id)
+}
+
+func TestProjectID_Viewer(t *testing.T) {
+	defer gock.Off()
+	gock.Observe(gock.DumpRequest)
+
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		JSON(map[string]interface{}{
+			"query": "query ViewerProject.*",
+			"variables": map[string]interface{}{
+				"number":      1,
+				"firstItems":  0,
+				"afterItems":  nil,
+				"firstFields": 0,
+				"afterFields": nil,
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"viewer": map[string]interface{}{
+					"projectV2": map[string]interface{}{
+						"id": "viewer-project-id",
+					},
+				},
+			},
+		})
+
+	client := NewTestClient()
+
+	id, err := client.ProjectID(&Owner{Type: ViewerOwner}, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, "viewer-project-id", id)
+}
+
+func TestHandleError_typedErrors(t *testing.T) {
+	defer gock.Off()
+	gock.Observe(gock.DumpRequest)
+
+	t.Run("project not found", func(t *testing.T) {
+		gock.New("https://api.github.com").
+			Post("/graphql").
+			Reply(200).
+			JSON(map[string]interface{}{
+				"data": map[string]interface{}{},
+				"errors": []interface{}{
+					map[string]interface{}{
+						"type":    "NOT_FOUND",
+						"message": "Could not resolve to a ProjectV2 with the number 1.",
+					},
+				},
+			})
+
+		client := NewTestClient()
+		_, err := client.ProjectID(&Owner{Login: "monalisa", Type: UserOwner}, 1)
+		assert.ErrorIs(t, err, ErrProjectNotFound)
+		assert.EqualError(t, err, "Could not resolve to a ProjectV2 with the number 1.")
+	})
+
+	t.Run("insufficient scopes", func(t *testing.T) {
+		gock.New("https://api.github.com").
+			Post("/graphql").
+			Reply(200).
+			JSON(map[string]interface{}{
+				"data": map[string]interface{}{},
+				"errors": []interface{}{
+					map[string]interface{}{
+						"type":    "INSUFFICIENT_SCOPES",
+						"message": "Your token has not been granted the required scopes to execute this query. The 'id' field requires one of the following scopes: [read:project], but your token has only been granted the: [repo] scopes.",
+					},
+				},
+			})
+
+		client := NewTestClient()
+		_, err := client.ProjectID(&Owner{Login: "monalisa", Type: UserOwner}, 1)
+		assert.ErrorIs(t, err, ErrInsufficientScope)
+		assert.EqualError(t, err, "error: your authentication token is missing required scopes [read:project]\nTo request it, run:  gh auth refresh -s read:project")
+	})
+}
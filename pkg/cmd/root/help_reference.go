@@ -4,6 +4,8 @@ import (
 	"bytes"
 	"fmt"
 	"io"
+	"os"
+	"sort"
 	"strings"
 
 	"github.com/cli/cli/v2/pkg/iostreams"
@@ -11,6 +13,9 @@ import (
 	"github.com/spf13/cobra"
 )
 
+// renderMarkdown is a hook for stubbing out glamour rendering in tests.
+var renderMarkdown = markdown.Render
+
 // longPager provides a pager over a commands Long message.
 // It is currently only used for the reference command
 func longPager(io *iostreams.IOStreams) func(*cobra.Command, []string) {
@@ -21,12 +26,39 @@ func longPager(io *iostreams.IOStreams) func(*cobra.Command, []string) {
 			wrapWidth = io.TerminalWidth()
 		}
 
-		md, err := markdown.Render(cmd.Long,
-			markdown.WithTheme(io.TerminalTheme()),
+		long := cmd.Long
+		includeFlags, includeLong, grouped, rebuild := true, false, false, false
+		for _, arg := range args {
+			switch arg {
+			case "--no-flags":
+				includeFlags = false
+				rebuild = true
+			case "--all":
+				includeLong = true
+				rebuild = true
+			case "--groups":
+				grouped = true
+				rebuild = true
+			}
+		}
+		if rebuild {
+			if grouped {
+				long = stringifyGroupedReference(cmd.Root(), includeFlags, false, includeLong)
+			} else {
+				long = stringifyReference(cmd.Root(), includeFlags, false, includeLong)
+			}
+		}
+
+		md, err := renderMarkdown(long,
+			markdown.WithTheme(resolveTheme(io)),
 			markdown.WithWrap(wrapWidth))
 		if err != nil {
-			fmt.Fprintln(io.ErrOut, err)
-			return
+			// Fall back to plain text so the reference stays readable, but
+			// surface the rendering failure when debugging is enabled.
+			if os.Getenv("GH_DEBUG") != "" {
+				fmt.Fprintf(io.ErrOut, "failed to render reference markdown: %v\n", err)
+			}
+			md = long
 		}
 
 		if !io.IsStdoutTTY() {
@@ -40,26 +72,75 @@ func longPager(io *iostreams.IOStreams) func(*cobra.Command, []string) {
 	}
 }
 
-func stringifyReference(cmd *cobra.Command) string {
+// resolveTheme honors a GH_THEME override (light, dark, or notty) over
+// terminal theme auto-detection, which is wrong in some terminals.
+func resolveTheme(io *iostreams.IOStreams) string {
+	switch theme := os.Getenv("GH_THEME"); theme {
+	case "light", "dark":
+		return theme
+	case "notty", "none":
+		return "none"
+	}
+	return io.TerminalTheme()
+}
+
+// stringifyReference produces the full command reference. When includeFlags
+// is false, flag blocks are omitted for a compact index of names and short
+// descriptions. When sorted is true, subcommands are listed alphabetically
+// instead of in registration order, for stable generated docs. When
+// includeLong is true, each command's full description follows its short one.
+func stringifyReference(cmd *cobra.Command, includeFlags, sorted, includeLong bool) string {
 	buf := bytes.NewBufferString("# gh reference\n\n")
-	for _, c := range cmd.Commands() {
+	for _, c := range subcommands(cmd, sorted) {
 		if c.Hidden {
 			continue
 		}
-		cmdRef(buf, c, 2)
+		cmdRef(buf, c, 2, includeFlags, sorted, includeLong)
 	}
 	return buf.String()
 }
 
-func cmdRef(w io.Writer, cmd *cobra.Command, depth int) {
+// stringifyGroupedReference renders the reference with top-level commands
+// bucketed under their command group headings, mirroring the grouping shown
+// in gh help. Ungrouped commands fall under the default group.
+func stringifyGroupedReference(cmd *cobra.Command, includeFlags, sorted, includeLong bool) string {
+	buf := bytes.NewBufferString("# gh reference\n\n")
+	for _, group := range GroupedCommands(cmd) {
+		fmt.Fprintf(buf, "## %s\n\n", group.Title)
+		for _, c := range group.Commands {
+			cmdRef(buf, c, 3, includeFlags, sorted, includeLong)
+		}
+	}
+	return buf.String()
+}
+
+func subcommands(cmd *cobra.Command, sorted bool) []*cobra.Command {
+	cmds := cmd.Commands()
+	if !sorted {
+		return cmds
+	}
+	sortedCmds := make([]*cobra.Command, len(cmds))
+	copy(sortedCmds, cmds)
+	sort.Slice(sortedCmds, func(i, j int) bool {
+		return sortedCmds[i].Name() < sortedCmds[j].Name()
+	})
+	return sortedCmds
+}
+
+func cmdRef(w io.Writer, cmd *cobra.Command, depth int, includeFlags, sorted, includeLong bool) {
 	// Name + Description
 	fmt.Fprintf(w, "%s `%s`\n\n", strings.Repeat("#", depth), cmd.UseLine())
 	fmt.Fprintf(w, "%s\n\n", cmd.Short)
+	if includeLong && cmd.Long != "" && cmd.Long != cmd.Short {
+		fmt.Fprintf(w, "%s\n\n", dedent(cmd.Long))
+	}
 
 	// Flags
 	// TODO: fold in InheritedFlags/PersistentFlags, but omit `--help` due to repetitiveness
-	if flagUsages := cmd.Flags().FlagUsages(); flagUsages != "" {
-		fmt.Fprintf(w, "```\n%s````\n\n", dedent(flagUsages))
+	if includeFlags {
+		if flagUsages := cmd.Flags().FlagUsages(); flagUsages != "" {
+			fmt.Fprintf(w, "```\n%s````\n\n", dedent(flagUsages))
+		}
 	}
 
 	// Aliases
@@ -69,10 +150,10 @@ func cmdRef(w io.Writer, cmd *cobra.Command, depth int) {
 	}
 
 	// Subcommands
-	for _, c := range cmd.Commands() {
+	for _, c := range subcommands(cmd, sorted) {
 		if c.Hidden {
 			continue
 		}
-		cmdRef(w, c, depth+1)
+		cmdRef(w, c, depth+1, includeFlags, sorted, includeLong)
 	}
 }
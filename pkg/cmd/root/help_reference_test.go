@@ -0,0 +1,163 @@
+package root
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/glamour"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResolveTheme(t *testing.T) {
+	tests := []struct {
+		name    string
+		ghTheme string
+		want    string
+	}{
+		{
+			name:    "no override falls back to detection",
+			ghTheme: "",
+			want:    "none",
+		},
+		{
+			name:    "light override",
+			ghTheme: "light",
+			want:    "light",
+		},
+		{
+			name:    "dark override",
+			ghTheme: "dark",
+			want:    "dark",
+		},
+		{
+			name:    "notty override",
+			ghTheme: "notty",
+			want:    "none",
+		},
+		{
+			name:    "invalid override falls back to detection",
+			ghTheme: "solarized",
+			want:    "none",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("GH_THEME", tt.ghTheme)
+			ios, _, _, _ := iostreams.Test()
+			assert.Equal(t, tt.want, resolveTheme(ios))
+		})
+	}
+}
+
+func TestStringifyReferenceNoFlags(t *testing.T) {
+	root := &cobra.Command{Use: "gh"}
+	child := &cobra.Command{Use: "child", Short: "A child command"}
+	child.Flags().Bool("verbose", false, "Enable verbose output")
+	root.AddCommand(child)
+
+	withFlags := stringifyReference(root, true, false, false)
+	assert.Contains(t, withFlags, "## `gh child [flags]`")
+	assert.Contains(t, withFlags, "--verbose")
+
+	withoutFlags := stringifyReference(root, false, false, false)
+	assert.Contains(t, withoutFlags, "## `gh child [flags]`")
+	assert.Contains(t, withoutFlags, "A child command")
+	assert.NotContains(t, withoutFlags, "--verbose")
+}
+
+func TestStringifyReferenceSorted(t *testing.T) {
+	cobra.EnableCommandSorting = false
+	defer func() { cobra.EnableCommandSorting = true }()
+
+	root := &cobra.Command{Use: "gh"}
+	root.AddCommand(&cobra.Command{Use: "zebra", Short: "Last alphabetically"})
+	root.AddCommand(&cobra.Command{Use: "apple", Short: "First alphabetically"})
+
+	unsorted := stringifyReference(root, false, false, false)
+	assert.Less(t, strings.Index(unsorted, "`gh zebra`"), strings.Index(unsorted, "`gh apple`"))
+
+	sorted := stringifyReference(root, false, true, false)
+	assert.Less(t, strings.Index(sorted, "`gh apple`"), strings.Index(sorted, "`gh zebra`"))
+	assert.Contains(t, sorted, "`gh apple`")
+	assert.Contains(t, sorted, "`gh zebra`")
+}
+
+func TestLongPagerRenderFailureFallback(t *testing.T) {
+	tests := []struct {
+		name       string
+		debug      string
+		wantStderr string
+	}{
+		{
+			name:       "render failure logged with GH_DEBUG",
+			debug:      "api",
+			wantStderr: "failed to render reference markdown: render exploded\n",
+		},
+		{
+			name:       "render failure silent by default",
+			debug:      "",
+			wantStderr: "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("GH_DEBUG", tt.debug)
+			origRender := renderMarkdown
+			renderMarkdown = func(text string, opts ...glamour.TermRendererOption) (string, error) {
+				return "", errors.New("render exploded")
+			}
+			defer func() { renderMarkdown = origRender }()
+
+			ios, _, stdout, stderr := iostreams.Test()
+			cmd := &cobra.Command{Long: "# gh reference\n"}
+			longPager(ios)(cmd, nil)
+
+			assert.Equal(t, "# gh reference\n", stdout.String())
+			assert.Equal(t, tt.wantStderr, stderr.String())
+		})
+	}
+}
+
+func TestStringifyReferenceIncludeLong(t *testing.T) {
+	root := &cobra.Command{Use: "gh"}
+	child := &cobra.Command{
+		Use:   "child",
+		Short: "A short description",
+		Long:  "A much longer description\nspanning several lines.",
+	}
+	root.AddCommand(child)
+
+	short := stringifyReference(root, false, false, false)
+	assert.NotContains(t, short, "A much longer description")
+
+	long := stringifyReference(root, false, false, true)
+	assert.Contains(t, long, "A short description")
+	assert.Contains(t, long, "A much longer description\nspanning several lines.")
+}
+
+func TestStringifyGroupedReference(t *testing.T) {
+	root := &cobra.Command{Use: "gh"}
+	root.AddGroup(&cobra.Group{ID: "core", Title: "Core commands"})
+	root.AddCommand(&cobra.Command{
+		Use:     "repo",
+		Short:   "Work with repositories",
+		GroupID: "core",
+		Run:     func(*cobra.Command, []string) {},
+	})
+	root.AddCommand(&cobra.Command{
+		Use:   "alias",
+		Short: "Create command shortcuts",
+		Run:   func(*cobra.Command, []string) {},
+	})
+
+	out := stringifyGroupedReference(root, false, false, false)
+	assert.Contains(t, out, "## Core commands\n")
+	assert.Contains(t, out, "## Additional commands\n")
+	// the grouped command renders under its heading, before the default group
+	assert.Less(t, strings.Index(out, "## Core commands"), strings.Index(out, "`gh repo`"))
+	assert.Less(t, strings.Index(out, "`gh repo`"), strings.Index(out, "## Additional commands"))
+	assert.Less(t, strings.Index(out, "## Additional commands"), strings.Index(out, "`gh alias`"))
+}
@@ -99,6 +99,9 @@ var HelpTopics = []helpTopic{
 
 			%[1]sGH_PATH%[1]s: set the path to the gh executable, useful for when gh can not properly determine
 			its own path such as in the cygwin terminal.
+
+			%[1]sGH_GIT_PATH%[1]s: set the path to the git executable to invoke instead of looking up
+			%[1]sgit%[1]s on the PATH.
 		`, "`"),
 	},
 	{
@@ -217,7 +217,10 @@ func NewCmdRoot(f *cmdutil.Factory, version, buildDate string) (*cobra.Command,
 	// We experimented with producing the paged output dynamically when the HelpFunc is called but since
 	// doc generation makes use of the Long text, it is simpler to just be explicit here that this command
 	// is special.
-	referenceCmd.Long = stringifyReference(cmd)
+	referenceCmd.Long = stringifyReference(cmd, true, false, false)
+	referenceCmd.Flags().Bool("no-flags", false, "Output a compact index without flag details")
+	referenceCmd.Flags().Bool("all", false, "Include full command descriptions in the reference")
+	referenceCmd.Flags().Bool("groups", false, "Group commands under their gh help headings")
 	referenceCmd.SetHelpFunc(longPager(f.IOStreams))
 	return cmd, nil
 }
@@ -40,6 +40,10 @@ var CancelError = errors.New("CancelError")
 // PendingError signals nothing failed but something is pending
 var PendingError = errors.New("PendingError")
 
+// PartialSuccessError signals that some operations in a bulk command
+// succeeded while others failed
+var PartialSuccessError = errors.New("PartialSuccessError")
+
 func IsUserCancellation(err error) bool {
 	return errors.Is(err, CancelError) || errors.Is(err, terminal.InterruptErr)
 }
@@ -1,10 +1,12 @@
 package cmdutil
 
 import (
+	"fmt"
 	"os"
 	"sort"
 	"strings"
 
+	"github.com/cli/cli/v2/context"
 	"github.com/cli/cli/v2/internal/ghrepo"
 	"github.com/spf13/cobra"
 )
@@ -68,3 +70,54 @@ func OverrideBaseRepoFunc(f *Factory, override string) func() (ghrepo.Interface,
 	}
 	return f.BaseRepo
 }
+
+// EnableRemoteOverride adds a persistent `--remote` flag that scopes repository
+// resolution to the named git remote, e.g. to target `upstream` over `origin`.
+// It chains with any PersistentPreRunE already set, such as EnableRepoOverride's.
+//
+// Subcommands copy f.Remotes into their options as they are constructed,
+// before any flags are parsed, so the wrapper is installed on the factory
+// here and the override name is filled in by the pre-run hook. For the
+// override to reach every subcommand, this must be called before they are
+// added to cmd.
+func EnableRemoteOverride(cmd *cobra.Command, f *Factory) {
+	cmd.PersistentFlags().String("remote", "", "Use the named git remote when resolving the repository")
+
+	var remoteOverride string
+	remotesFn := f.Remotes
+	f.Remotes = func() (context.Remotes, error) {
+		if remoteOverride == "" {
+			return remotesFn()
+		}
+		return filterRemotesByName(remotesFn, remoteOverride)()
+	}
+
+	preRun := cmd.PersistentPreRunE
+	cmd.PersistentPreRunE = func(cmd *cobra.Command, args []string) error {
+		if preRun != nil {
+			if err := preRun(cmd, args); err != nil {
+				return err
+			}
+		}
+		remoteOverride, _ = cmd.Flags().GetString("remote")
+		return nil
+	}
+}
+
+func OverrideRemotesFunc(f *Factory, name string) func() (context.Remotes, error) {
+	return filterRemotesByName(f.Remotes, name)
+}
+
+func filterRemotesByName(remotesFn func() (context.Remotes, error), name string) func() (context.Remotes, error) {
+	return func() (context.Remotes, error) {
+		remotes, err := remotesFn()
+		if err != nil {
+			return nil, err
+		}
+		remote, err := remotes.FindByName(name)
+		if err != nil {
+			return nil, fmt.Errorf("no GitHub remote named %q", name)
+		}
+		return context.Remotes{remote}, nil
+	}
+}
@@ -0,0 +1,42 @@
+package cmdutil
+
+import (
+	"testing"
+
+	"github.com/cli/cli/v2/context"
+	"github.com/cli/cli/v2/git"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOverrideRemotesFunc(t *testing.T) {
+	remotes := context.Remotes{
+		{
+			Remote: &git.Remote{Name: "origin"},
+			Repo:   ghrepo.New("monalisa", "fork"),
+		},
+		{
+			Remote: &git.Remote{Name: "upstream"},
+			Repo:   ghrepo.New("OWNER", "REPO"),
+		},
+	}
+	f := &Factory{
+		Remotes: func() (context.Remotes, error) {
+			return remotes, nil
+		},
+	}
+
+	t.Run("scopes resolution to the named remote", func(t *testing.T) {
+		overridden, err := OverrideRemotesFunc(f, "upstream")()
+		require.NoError(t, err)
+		require.Len(t, overridden, 1)
+		assert.Equal(t, "upstream", overridden[0].Name)
+		assert.Equal(t, "OWNER/REPO", ghrepo.FullName(overridden[0].Repo))
+	})
+
+	t.Run("errors when the remote does not resolve", func(t *testing.T) {
+		_, err := OverrideRemotesFunc(f, "fork")()
+		assert.EqualError(t, err, `no GitHub remote named "fork"`)
+	})
+}
@@ -5,30 +5,62 @@ package surveyext
 // To see what we extended, search through for EXTENDED comments.
 
 import (
+	"context"
 	"os"
 	"path/filepath"
 	"runtime"
+	"strings"
+	"sync"
 
 	"github.com/AlecAivazis/survey/v2"
 	"github.com/AlecAivazis/survey/v2/terminal"
+	"github.com/cli/cli/v2/git"
 	shellquote "github.com/kballard/go-shellquote"
 )
 
-var (
-	bom           = []byte{0xef, 0xbb, 0xbf}
-	defaultEditor = "nano" // EXTENDED to switch from vim as a default editor
-)
+var bom = []byte{0xef, 0xbb, 0xbf}
+
+// defaultEditor resolves the editor to use when none is configured, cached
+// after the first call. EXTENDED to switch from vim as a default editor.
+var defaultEditor = sync.OnceValue(func() string {
+	return lookupDefaultEditor(os.Getenv, gitCoreEditor)
+})
+
+// lookupDefaultEditor mirrors the fallback order of git commit: editor
+// environment variables win, then git's core.editor setting, then the
+// platform default.
+func lookupDefaultEditor(getenv func(string) string, coreEditor func() string) string {
+	if g := getenv("GIT_EDITOR"); g != "" {
+		return g
+	}
+	if v := getenv("VISUAL"); v != "" {
+		return v
+	}
+	if e := getenv("EDITOR"); e != "" {
+		return e
+	}
+	if ce := coreEditor(); ce != "" {
+		return ce
+	}
+	if runtime.GOOS == "windows" {
+		return "notepad"
+	}
+	return "nano"
+}
 
-func init() {
-	if g := os.Getenv("GIT_EDITOR"); g != "" {
-		defaultEditor = g
-	} else if v := os.Getenv("VISUAL"); v != "" {
-		defaultEditor = v
-	} else if e := os.Getenv("EDITOR"); e != "" {
-		defaultEditor = e
-	} else if runtime.GOOS == "windows" {
-		defaultEditor = "notepad"
+// gitCoreEditor reads git's core.editor setting so gh honors the same editor
+// as git commit.
+func gitCoreEditor() string {
+	out, err := (&git.Client{}).Config(context.Background(), "core.editor")
+	if err != nil {
+		return ""
 	}
+	return strings.TrimSpace(out)
+}
+
+// DefaultEditorName reports the editor command used when none is configured.
+func DefaultEditorName() string {
+	return defaultEditor()
 }
 
 // EXTENDED to enable different prompting behavior
@@ -161,7 +193,7 @@ func (e *GhEditor) Prompt(config *survey.PromptConfig) (interface{}, error) {
 
 func EditorName(editorCommand string) string {
 	if editorCommand == "" {
-		editorCommand = defaultEditor
+		editorCommand = defaultEditor()
 	}
 	if args, err := shellquote.Split(editorCommand); err == nil {
 		editorCommand = args[0]
@@ -70,7 +70,7 @@ func edit(editorCommand, fn, initialValue string, stdin io.Reader, stdout io.Wri
 	}
 
 	if editorCommand == "" {
-		editorCommand = defaultEditor
+		editorCommand = defaultEditor()
 	}
 	args, err := shellquote.Split(editorCommand)
 	if err != nil {
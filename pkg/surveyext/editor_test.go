@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"runtime"
 	"strings"
 	"sync"
 	"testing"
@@ -282,3 +283,53 @@ func normalizeANSI(t string) string {
 	t = strings.ReplaceAll(t, "\x1b[?25l", "") // strip sequence that hides cursor
 	return t
 }
+
+func Test_lookupDefaultEditor(t *testing.T) {
+	env := func(vars map[string]string) func(string) string {
+		return func(name string) string { return vars[name] }
+	}
+	coreEditor := func(value string) func() string {
+		return func() string { return value }
+	}
+
+	platformDefault := "nano"
+	if runtime.GOOS == "windows" {
+		platformDefault = "notepad"
+	}
+
+	tests := []struct {
+		name       string
+		env        map[string]string
+		coreEditor string
+		want       string
+	}{
+		{
+			name:       "core.editor used when no env vars are set",
+			env:        map[string]string{},
+			coreEditor: "emacs -nw",
+			want:       "emacs -nw",
+		},
+		{
+			name:       "GIT_EDITOR wins over core.editor",
+			env:        map[string]string{"GIT_EDITOR": "vim", "EDITOR": "vi"},
+			coreEditor: "emacs -nw",
+			want:       "vim",
+		},
+		{
+			name:       "EDITOR wins over core.editor",
+			env:        map[string]string{"EDITOR": "vi"},
+			coreEditor: "emacs -nw",
+			want:       "vi",
+		},
+		{
+			name: "platform default when nothing is configured",
+			env:  map[string]string{},
+			want: platformDefault,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, lookupDefaultEditor(env(tt.env), coreEditor(tt.coreEditor)))
+		})
+	}
+}